@@ -0,0 +1,36 @@
+package drive
+
+import (
+	"fmt"
+	"net/http"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// SpreadsheetMimeType is the Drive MIME type of native Google Sheets files
+const SpreadsheetMimeType = "application/vnd.google-apps.spreadsheet"
+
+// Client wraps the Google Drive API service. httpClient is the authenticated
+// client behind service, used for export links the API returns as plain URLs.
+type Client struct {
+	service      *drive.Service
+	httpClient   *http.Client
+	shareDomains []string
+	exportDirs   []string
+}
+
+// NewClient creates a new Drive client
+func NewClient(service *drive.Service, httpClient *http.Client) *Client {
+	return &Client{
+		service:    service,
+		httpClient: httpClient,
+	}
+}
+
+// checkService returns an error if the client has no Drive service to call
+func (c *Client) checkService() error {
+	if c.service == nil {
+		return fmt.Errorf("drive service is not initialized")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package drive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+)
+
+// mockDriveService creates a mock Google Drive service for testing
+func mockDriveService(t testing.TB, handler http.HandlerFunc) (*drive.Service, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	service, err := drive.NewService(context.Background(), option.WithHTTPClient(server.Client()), option.WithEndpoint(server.URL))
+	if err != nil {
+		t.Fatalf("Failed to create mock drive service: %v", err)
+		server.Close()
+		return nil, nil
+	}
+	return service, server
+}
+
+func TestNewClient(t *testing.T) {
+	service, server := mockDriveService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	if client == nil {
+		t.Fatal("NewClient returned nil")
+	}
+
+	if client.service == nil {
+		t.Error("Client service should not be nil")
+	}
+}
+
+func TestClient_NilService(t *testing.T) {
+	client := NewClient(nil, nil)
+
+	if _, err := client.ListSpreadsheets(context.Background(), "", 0, ""); err == nil {
+		t.Error("Expected error when service is not initialized")
+	}
+}
@@ -0,0 +1,199 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/drive/v3"
+)
+
+// commentFields lists the comment properties returned by the comment tools
+const commentFields = "id,content,author(displayName,emailAddress),createdTime,modifiedTime,resolved,anchor,quotedFileContent," +
+	"replies(" + replyFields + ")"
+
+// replyFields lists the reply properties returned by the comment tools
+const replyFields = "id,content,action,author(displayName,emailAddress),createdTime"
+
+// authorInfo renders a comment or reply author for tool output
+func authorInfo(info map[string]interface{}, author *drive.User) {
+	if author == nil {
+		return
+	}
+	info["author"] = author.DisplayName
+	if author.EmailAddress != "" {
+		info["author_email"] = author.EmailAddress
+	}
+}
+
+// replyInfo summarises a comment reply for tool output
+func replyInfo(r *drive.Reply) map[string]interface{} {
+	info := map[string]interface{}{
+		"reply_id":     r.Id,
+		"content":      r.Content,
+		"created_time": r.CreatedTime,
+	}
+	if r.Action != "" {
+		info["action"] = r.Action
+	}
+	authorInfo(info, r.Author)
+	return info
+}
+
+// commentInfo summarises a comment and its replies for tool output. The
+// anchor is Drive's opaque location string; quoted_text is the cell content
+// the comment was made on, when Drive recorded it.
+func commentInfo(c *drive.Comment) map[string]interface{} {
+	info := map[string]interface{}{
+		"comment_id":    c.Id,
+		"content":       c.Content,
+		"created_time":  c.CreatedTime,
+		"modified_time": c.ModifiedTime,
+		"resolved":      c.Resolved,
+	}
+	authorInfo(info, c.Author)
+	if c.Anchor != "" {
+		info["anchor"] = c.Anchor
+	}
+	if c.QuotedFileContent != nil && c.QuotedFileContent.Value != "" {
+		info["quoted_text"] = c.QuotedFileContent.Value
+	}
+	replies := make([]map[string]interface{}, len(c.Replies))
+	for i, r := range c.Replies {
+		replies[i] = replyInfo(r)
+	}
+	info["replies"] = replies
+	return info
+}
+
+// ListComments lists the comments on a spreadsheet with their replies.
+// Resolved comments are skipped unless includeResolved is set.
+func (c *Client) ListComments(ctx context.Context, spreadsheetID string, includeResolved bool) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	comments := []map[string]interface{}{}
+	err := c.service.Comments.List(spreadsheetID).
+		Fields("nextPageToken,comments("+commentFields+")").
+		Pages(ctx, func(page *drive.CommentList) error {
+			for _, comment := range page.Comments {
+				if comment.Resolved && !includeResolved {
+					continue
+				}
+				comments = append(comments, commentInfo(comment))
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve comments: %v", err)
+	}
+
+	return map[string]interface{}{
+		"spreadsheet_id": spreadsheetID,
+		"comments":       comments,
+		"count":          len(comments),
+	}, nil
+}
+
+// CreateComment adds a comment to a spreadsheet. Comments created through
+// the API apply to the whole file rather than a cell.
+func (c *Client) CreateComment(ctx context.Context, spreadsheetID, content string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("content is required")
+	}
+
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.preview(ctx, spreadsheetID, "create comment", map[string]interface{}{"content": content})
+	}
+
+	created, err := c.service.Comments.Create(spreadsheetID, &drive.Comment{Content: content}).
+		Fields(commentFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create comment: %v", err)
+	}
+
+	result := commentInfo(created)
+	result["message"] = "Comment created successfully"
+	return result, nil
+}
+
+// addReply posts a reply to a comment, optionally with an action such as "resolve"
+func (c *Client) addReply(ctx context.Context, spreadsheetID, commentID string, reply *drive.Reply, message string) (interface{}, error) {
+	if commentID == "" {
+		return nil, fmt.Errorf("comment_id is required")
+	}
+
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	if dryrun.Enabled(ctx) {
+		comment, err := c.service.Comments.Get(spreadsheetID, commentID).
+			Fields("id,resolved").
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve comment: %v", err)
+		}
+		action := "reply to comment"
+		if reply.Action == "resolve" {
+			action = "resolve comment"
+		}
+		return c.preview(ctx, spreadsheetID, action, map[string]interface{}{
+			"comment_id": comment.Id,
+			"resolved":   comment.Resolved,
+			"content":    reply.Content,
+		})
+	}
+
+	created, err := c.service.Replies.Create(spreadsheetID, commentID, reply).
+		Fields(replyFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to reply to comment: %v", err)
+	}
+
+	result := replyInfo(created)
+	result["comment_id"] = commentID
+	result["message"] = message
+	return result, nil
+}
+
+// ReplyToComment adds a reply to an existing comment
+func (c *Client) ReplyToComment(ctx context.Context, spreadsheetID, commentID, content string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("content is required")
+	}
+
+	return c.addReply(ctx, spreadsheetID, commentID, &drive.Reply{Content: content}, "Reply added successfully")
+}
+
+// ResolveComment marks a comment as resolved, with an optional closing reply
+func (c *Client) ResolveComment(ctx context.Context, spreadsheetID, commentID, content string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	return c.addReply(ctx, spreadsheetID, commentID, &drive.Reply{Content: content, Action: "resolve"}, "Comment resolved successfully")
+}
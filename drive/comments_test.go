@@ -0,0 +1,161 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// spreadsheetHandler serves the spreadsheet's file metadata and passes
+// comment requests on to next
+func spreadsheetHandler(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && !strings.Contains(r.URL.Path, "/comments") {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&drive.File{Id: "sheet-id", MimeType: SpreadsheetMimeType})
+			return
+		}
+		next(w, r)
+	}
+}
+
+func TestListComments_SkipsResolved(t *testing.T) {
+	handler := spreadsheetHandler(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Query().Get("fields"), "replies(") {
+			t.Errorf("Expected replies to be requested, got fields %q", r.URL.Query().Get("fields"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&drive.CommentList{
+			Comments: []*drive.Comment{
+				{
+					Id:                "c1",
+					Content:           "Is this total right?",
+					Author:            &drive.User{DisplayName: "Alice"},
+					Anchor:            "{\"type\":\"workbook-range\",\"uid\":0,\"range\":\"1234\"}",
+					QuotedFileContent: &drive.CommentQuotedFileContent{Value: "4,200"},
+					Replies:           []*drive.Reply{{Id: "r1", Content: "Checking"}},
+				},
+				{Id: "c2", Content: "Done", Resolved: true},
+			},
+		})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	result, err := client.ListComments(context.Background(), "sheet-id", false)
+	if err != nil {
+		t.Fatalf("ListComments failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["count"] != 1 {
+		t.Fatalf("Expected 1 open comment, got %v", resultMap["count"])
+	}
+	comment := resultMap["comments"].([]map[string]interface{})[0]
+	if comment["quoted_text"] != "4,200" || comment["author"] != "Alice" || comment["anchor"] == nil {
+		t.Errorf("Unexpected comment info: %v", comment)
+	}
+	if replies := comment["replies"].([]map[string]interface{}); len(replies) != 1 || replies[0]["reply_id"] != "r1" {
+		t.Errorf("Unexpected replies: %v", replies)
+	}
+
+	result, err = client.ListComments(context.Background(), "sheet-id", true)
+	if err != nil {
+		t.Fatalf("ListComments failed: %v", err)
+	}
+	if result.(map[string]interface{})["count"] != 2 {
+		t.Errorf("Expected resolved comments to be included")
+	}
+}
+
+func TestResolveComment_Success(t *testing.T) {
+	var path string
+	var received drive.Reply
+	handler := spreadsheetHandler(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		json.NewDecoder(r.Body).Decode(&received)
+		received.Id = "r2"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&received)
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	result, err := client.ResolveComment(context.Background(), "sheet-id", "c1", "Fixed the formula")
+	if err != nil {
+		t.Fatalf("ResolveComment failed: %v", err)
+	}
+
+	if !strings.HasSuffix(path, "/files/sheet-id/comments/c1/replies") {
+		t.Errorf("Unexpected request path: %s", path)
+	}
+	if received.Action != "resolve" || received.Content != "Fixed the formula" {
+		t.Errorf("Unexpected reply: %+v", received)
+	}
+	if result.(map[string]interface{})["comment_id"] != "c1" {
+		t.Errorf("Unexpected result: %v", result)
+	}
+}
+
+func TestCommentValidation(t *testing.T) {
+	service, server := mockDriveService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request expected")
+	}))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	ctx := context.Background()
+
+	if _, err := client.CreateComment(ctx, "sheet-id", " "); err == nil {
+		t.Error("Expected error for empty comment")
+	}
+	if _, err := client.ReplyToComment(ctx, "sheet-id", "c1", ""); err == nil {
+		t.Error("Expected error for empty reply")
+	}
+	if _, err := client.ResolveComment(ctx, "sheet-id", "", ""); err == nil {
+		t.Error("Expected error for missing comment ID")
+	}
+}
+
+func TestComments_RefuseNonSpreadsheet(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/comments") {
+			t.Errorf("Unexpected comment request for a folder: %s %s", r.Method, r.URL.Path)
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&drive.File{Id: "folder-id", MimeType: "application/vnd.google-apps.folder"})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	ctx := context.Background()
+
+	calls := map[string]func() (interface{}, error){
+		"list":    func() (interface{}, error) { return client.ListComments(ctx, "folder-id", false) },
+		"create":  func() (interface{}, error) { return client.CreateComment(ctx, "folder-id", "Hello") },
+		"reply":   func() (interface{}, error) { return client.ReplyToComment(ctx, "folder-id", "c1", "Thanks") },
+		"resolve": func() (interface{}, error) { return client.ResolveComment(ctx, "folder-id", "c1", "") },
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			_, err := call()
+			if err == nil || !strings.Contains(err.Error(), "not a Google Sheets spreadsheet") {
+				t.Errorf("Expected error for a folder, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package drive
+
+import (
+	"context"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+)
+
+// preview reports a change instead of making it. The spreadsheet is still
+// read, so a preview fails just as the change would for a missing or
+// inaccessible file, or one that is not a spreadsheet.
+func (c *Client) preview(ctx context.Context, spreadsheetID, action string, details map[string]interface{}) (interface{}, error) {
+	file, err := c.spreadsheetFile(ctx, spreadsheetID, "id,name")
+	if err != nil {
+		return nil, err
+	}
+
+	details["dry_run"] = true
+	details["action"] = action
+	details["spreadsheet_id"] = file.Id
+	details["current_name"] = file.Name
+	details["message"] = dryrun.Message
+	return details, nil
+}
@@ -0,0 +1,90 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/drive/v3"
+)
+
+// readOnlyHandler serves file and comment lookups and fails the test on any
+// request that would change something
+func readOnlyHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet {
+			t.Errorf("Dry run sent a mutating request: %s %s", r.Method, r.URL.Path)
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(r.URL.Path, "/comments/") {
+			json.NewEncoder(w).Encode(&drive.Comment{Id: "c1"})
+			return
+		}
+		json.NewEncoder(w).Encode(&drive.File{Id: "sheet-1", Name: "Budget", MimeType: SpreadsheetMimeType})
+	}
+}
+
+func TestShareSpreadsheet_DryRun(t *testing.T) {
+	service, server := mockDriveService(t, readOnlyHandler(t))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	client.AllowShareDomains("example.com")
+	ctx := dryrun.With(context.Background())
+
+	result, err := client.ShareSpreadsheet(ctx, "sheet-1", ShareOptions{Type: "user", Role: "writer", EmailAddress: "a@example.com"})
+	if err != nil {
+		t.Fatalf("ShareSpreadsheet failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["dry_run"] != true || resultMap["action"] != "share spreadsheet" || resultMap["current_name"] != "Budget" {
+		t.Errorf("Unexpected preview: %v", resultMap)
+	}
+	if resultMap["email_address"] != "a@example.com" || resultMap["notify"] != true {
+		t.Errorf("Expected preview to describe the grantee, got %v", resultMap)
+	}
+
+	// The allowlist is still enforced
+	_, err = client.ShareSpreadsheet(ctx, "sheet-1", ShareOptions{Type: "user", Role: "writer", EmailAddress: "a@other.com"})
+	if err == nil {
+		t.Error("Expected error for a domain outside the allowlist")
+	}
+}
+
+func TestSetTrashed_DryRun(t *testing.T) {
+	service, server := mockDriveService(t, readOnlyHandler(t))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	result, err := client.SetTrashed(dryrun.With(context.Background()), "sheet-1", true)
+	if err != nil {
+		t.Fatalf("SetTrashed failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["action"] != "trash spreadsheet" || resultMap["trashed"] != true {
+		t.Errorf("Unexpected preview: %v", resultMap)
+	}
+}
+
+func TestResolveComment_DryRun(t *testing.T) {
+	service, server := mockDriveService(t, readOnlyHandler(t))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	result, err := client.ResolveComment(dryrun.With(context.Background()), "sheet-1", "c1", "Done")
+	if err != nil {
+		t.Fatalf("ResolveComment failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["action"] != "resolve comment" || resultMap["comment_id"] != "c1" || resultMap["content"] != "Done" {
+		t.Errorf("Unexpected preview: %v", resultMap)
+	}
+}
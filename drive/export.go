@@ -0,0 +1,142 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxExportSize caps how much an export download may write to disk
+const maxExportSize = 100 << 20
+
+// exportFormats maps the export formats offered by the tools to MIME types
+var exportFormats = map[string]string{
+	"csv":  "text/csv",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"pdf":  "application/pdf",
+}
+
+// AllowExportDirs sets the local directories exports may be written to. The
+// first directory receives exports given a relative or empty path; exports
+// are refused while the list is empty.
+func (c *Client) AllowExportDirs(dirs ...string) {
+	c.exportDirs = nil
+	for _, dir := range dirs {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			c.exportDirs = append(c.exportDirs, filepath.Clean(dir))
+		}
+	}
+}
+
+// withinDir reports whether path is dir or lies beneath it
+func withinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// exportPath resolves where an export should be written, rejecting paths
+// outside the allowed export directories. Missing directories inside an
+// allowed directory are created.
+func (c *Client) exportPath(outputPath, defaultName string) (string, error) {
+	if len(c.exportDirs) == 0 {
+		return "", fmt.Errorf("exports are disabled: no export directories are configured on the server")
+	}
+
+	if outputPath == "" {
+		outputPath = defaultName
+	}
+	if !filepath.IsAbs(outputPath) {
+		outputPath = filepath.Join(c.exportDirs[0], outputPath)
+	}
+	outputPath = filepath.Clean(outputPath)
+
+	for _, dir := range c.exportDirs {
+		if !withinDir(dir, outputPath) || outputPath == dir {
+			continue
+		}
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return "", fmt.Errorf("unable to create export directory: %v", err)
+		}
+
+		// Resolve symlinks so a link inside the directory cannot point outside it
+		realDir, err := filepath.EvalSymlinks(dir)
+		if err != nil {
+			return "", fmt.Errorf("unable to resolve export directory: %v", err)
+		}
+		existing := filepath.Dir(outputPath)
+		for {
+			if _, err := os.Lstat(existing); err == nil {
+				break
+			}
+			existing = filepath.Dir(existing)
+		}
+		realExisting, err := filepath.EvalSymlinks(existing)
+		if err != nil || !withinDir(realDir, realExisting) {
+			break
+		}
+
+		if err := os.MkdirAll(filepath.Dir(outputPath), 0700); err != nil {
+			return "", fmt.Errorf("unable to create export directory: %v", err)
+		}
+		if info, err := os.Lstat(outputPath); err == nil && info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("output path %q is a symbolic link", outputPath)
+		}
+		return outputPath, nil
+	}
+
+	return "", fmt.Errorf("output path %q is outside the allowed export directories", outputPath)
+}
+
+// download fetches an export URL with the authenticated HTTP client
+func (c *Client) download(ctx context.Context, url string) ([]byte, error) {
+	if c.httpClient == nil {
+		return nil, fmt.Errorf("drive HTTP client is not initialized")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid export URL: %v", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download export: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to download export: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxExportSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read export: %v", err)
+	}
+	if len(body) > maxExportSize {
+		return nil, fmt.Errorf("export exceeds the %d MB limit", maxExportSize>>20)
+	}
+	return body, nil
+}
+
+// writeExport downloads url and saves it to an allowed export path,
+// returning the path written
+func (c *Client) writeExport(ctx context.Context, url, outputPath, defaultName string) (string, []byte, error) {
+	path, err := c.exportPath(outputPath, defaultName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body, err := c.download(ctx, url)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := os.WriteFile(path, body, 0600); err != nil {
+		return "", nil, fmt.Errorf("unable to write export: %v", err)
+	}
+	return path, body, nil
+}
@@ -0,0 +1,54 @@
+package drive
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExportPath(t *testing.T) {
+	client := NewClient(nil, nil)
+
+	if _, err := client.exportPath("report.csv", "default.csv"); err == nil {
+		t.Error("Expected exports to be disabled without export directories")
+	}
+
+	root := t.TempDir()
+	exports := filepath.Join(root, "exports")
+	other := filepath.Join(root, "other")
+	client.AllowExportDirs(exports, "", other)
+
+	path, err := client.exportPath("", "default.csv")
+	if err != nil {
+		t.Fatalf("exportPath failed: %v", err)
+	}
+	if path != filepath.Join(exports, "default.csv") {
+		t.Errorf("Unexpected default path: %s", path)
+	}
+
+	path, err = client.exportPath(filepath.Join(other, "reports", "march.pdf"), "default.pdf")
+	if err != nil {
+		t.Fatalf("exportPath failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Dir(path)); err != nil {
+		t.Errorf("Expected nested directory to be created: %v", err)
+	}
+
+	outside := []string{
+		"../escape.csv",
+		filepath.Join(root, "escape.csv"),
+		exports,
+	}
+	for _, p := range outside {
+		if _, err := client.exportPath(p, "default.csv"); err == nil {
+			t.Errorf("Expected error for %q", p)
+		}
+	}
+
+	if err := os.Symlink(root, filepath.Join(exports, "link")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	if _, err := client.exportPath("link/escape.csv", "default.csv"); err == nil {
+		t.Error("Expected error for a path through a symlink leaving the export directory")
+	}
+}
@@ -0,0 +1,204 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// spreadsheetURL returns the browser URL for a spreadsheet
+func spreadsheetURL(spreadsheetID string) string {
+	return "https://docs.google.com/spreadsheets/d/" + spreadsheetID + "/edit"
+}
+
+// fileResult describes a file after a file-level operation for tool output
+func fileResult(f *drive.File, message string) map[string]interface{} {
+	url := f.WebViewLink
+	if url == "" {
+		url = spreadsheetURL(f.Id)
+	}
+	result := map[string]interface{}{
+		"spreadsheet_id": f.Id,
+		"name":           f.Name,
+		"url":            url,
+		"message":        message,
+	}
+	if len(f.Parents) > 0 {
+		result["parents"] = f.Parents
+	}
+	return result
+}
+
+// spreadsheetFile fetches a file's metadata and refuses anything that is not
+// a native Google Sheets file, so the spreadsheet tools cannot act on folders
+// or other documents the Drive scope can reach
+func (c *Client) spreadsheetFile(ctx context.Context, spreadsheetID, fields string) (*drive.File, error) {
+	file, err := c.service.Files.Get(spreadsheetID).
+		SupportsAllDrives(true).
+		Fields("mimeType", googleapi.Field(fields)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve spreadsheet: %v", err)
+	}
+	if file.MimeType != SpreadsheetMimeType {
+		return nil, fmt.Errorf("file %s is not a Google Sheets spreadsheet (MIME type %s)", spreadsheetID, file.MimeType)
+	}
+	return file, nil
+}
+
+// CopySpreadsheet copies a whole spreadsheet, for example from a template.
+// An empty name keeps Drive's default "Copy of <name>"; an empty folderID
+// places the copy alongside the original.
+func (c *Client) CopySpreadsheet(ctx context.Context, spreadsheetID, name, folderID string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	file := &drive.File{Name: name}
+	if folderID != "" {
+		file.Parents = []string{folderID}
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.preview(ctx, spreadsheetID, "copy spreadsheet", map[string]interface{}{
+			"copy_name": name,
+			"folder_id": folderID,
+		})
+	}
+
+	copied, err := c.service.Files.Copy(spreadsheetID, file).
+		SupportsAllDrives(true).
+		Fields("id,name,parents,webViewLink").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy spreadsheet: %v", err)
+	}
+
+	result := fileResult(copied, "Spreadsheet copied successfully")
+	result["source_spreadsheet_id"] = spreadsheetID
+	return result, nil
+}
+
+// updateFile applies a metadata update to a file and returns its new state
+func (c *Client) updateFile(ctx context.Context, spreadsheetID string, file *drive.File, action string) (*drive.File, error) {
+	updated, err := c.service.Files.Update(spreadsheetID, file).
+		SupportsAllDrives(true).
+		Fields("id,name,parents,trashed,webViewLink").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to %s: %v", action, err)
+	}
+	return updated, nil
+}
+
+// RenameSpreadsheet changes a spreadsheet's file name
+func (c *Client) RenameSpreadsheet(ctx context.Context, spreadsheetID, name string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.preview(ctx, spreadsheetID, "rename spreadsheet", map[string]interface{}{"new_name": name})
+	}
+
+	updated, err := c.updateFile(ctx, spreadsheetID, &drive.File{Name: name}, "rename spreadsheet")
+	if err != nil {
+		return nil, err
+	}
+
+	return fileResult(updated, "Spreadsheet renamed successfully"), nil
+}
+
+// MoveSpreadsheet moves a spreadsheet into a folder, removing it from all of
+// its current folders
+func (c *Client) MoveSpreadsheet(ctx context.Context, spreadsheetID, folderID string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id is required")
+	}
+
+	current, err := c.spreadsheetFile(ctx, spreadsheetID, "parents")
+	if err != nil {
+		return nil, err
+	}
+
+	var previous []string
+	for _, parent := range current.Parents {
+		if parent != folderID {
+			previous = append(previous, parent)
+		}
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.preview(ctx, spreadsheetID, "move spreadsheet", map[string]interface{}{
+			"folder_id":        folderID,
+			"previous_parents": previous,
+		})
+	}
+
+	updated, err := c.service.Files.Update(spreadsheetID, &drive.File{}).
+		AddParents(folderID).
+		RemoveParents(strings.Join(previous, ",")).
+		SupportsAllDrives(true).
+		Fields("id,name,parents,webViewLink").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to move spreadsheet: %v", err)
+	}
+
+	result := fileResult(updated, "Spreadsheet moved successfully")
+	result["previous_parents"] = previous
+	return result, nil
+}
+
+// SetTrashed moves a spreadsheet to the trash or restores it from the trash
+func (c *Client) SetTrashed(ctx context.Context, spreadsheetID string, trashed bool) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	action, message := "restore spreadsheet", "Spreadsheet restored successfully"
+	if trashed {
+		action, message = "trash spreadsheet", "Spreadsheet moved to trash successfully"
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.preview(ctx, spreadsheetID, action, map[string]interface{}{"trashed": trashed})
+	}
+
+	file := &drive.File{Trashed: trashed, ForceSendFields: []string{"Trashed"}}
+	updated, err := c.updateFile(ctx, spreadsheetID, file, action)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fileResult(updated, message)
+	result["trashed"] = updated.Trashed
+	return result, nil
+}
@@ -0,0 +1,158 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+)
+
+func TestCopySpreadsheet_Success(t *testing.T) {
+	var received drive.File
+	var path string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(&drive.File{Id: "template-id", MimeType: SpreadsheetMimeType})
+			return
+		}
+		path = r.URL.Path
+		json.NewDecoder(r.Body).Decode(&received)
+		json.NewEncoder(w).Encode(&drive.File{Id: "copy-id", Name: received.Name, Parents: received.Parents})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	result, err := client.CopySpreadsheet(context.Background(), "template-id", "Acme workbook", "folder-1")
+	if err != nil {
+		t.Fatalf("CopySpreadsheet failed: %v", err)
+	}
+
+	if !strings.HasSuffix(path, "/files/template-id/copy") {
+		t.Errorf("Unexpected request path: %s", path)
+	}
+	if received.Name != "Acme workbook" || len(received.Parents) != 1 || received.Parents[0] != "folder-1" {
+		t.Errorf("Unexpected copy request: %+v", received)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["spreadsheet_id"] != "copy-id" || resultMap["source_spreadsheet_id"] != "template-id" {
+		t.Errorf("Unexpected result: %v", resultMap)
+	}
+	if resultMap["url"] != "https://docs.google.com/spreadsheets/d/copy-id/edit" {
+		t.Errorf("Unexpected url: %v", resultMap["url"])
+	}
+}
+
+func TestRenameSpreadsheet_RequiresName(t *testing.T) {
+	service, server := mockDriveService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request expected")
+	}))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	if _, err := client.RenameSpreadsheet(context.Background(), "sheet-id", " "); err == nil {
+		t.Error("Expected error for empty name")
+	}
+}
+
+func TestMoveSpreadsheet_Success(t *testing.T) {
+	var update url.Values
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(&drive.File{MimeType: SpreadsheetMimeType, Parents: []string{"old-1", "old-2"}})
+			return
+		}
+		update = r.URL.Query()
+		json.NewEncoder(w).Encode(&drive.File{Id: "sheet-id", Name: "Report", Parents: []string{"new"}})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	result, err := client.MoveSpreadsheet(context.Background(), "sheet-id", "new")
+	if err != nil {
+		t.Fatalf("MoveSpreadsheet failed: %v", err)
+	}
+
+	if update.Get("addParents") != "new" || update.Get("removeParents") != "old-1,old-2" {
+		t.Errorf("Unexpected move parameters: %v", update)
+	}
+	if got := result.(map[string]interface{})["previous_parents"].([]string); len(got) != 2 {
+		t.Errorf("Unexpected previous parents: %v", got)
+	}
+}
+
+func TestSetTrashed_Restore(t *testing.T) {
+	var body map[string]interface{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(&drive.File{Id: "sheet-id", MimeType: SpreadsheetMimeType})
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(&drive.File{Id: "sheet-id", Name: "Report"})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	result, err := client.SetTrashed(context.Background(), "sheet-id", false)
+	if err != nil {
+		t.Fatalf("SetTrashed failed: %v", err)
+	}
+
+	if trashed, ok := body["trashed"]; !ok || trashed != false {
+		t.Errorf("Expected trashed=false to be sent, got %v", body)
+	}
+	if result.(map[string]interface{})["message"] != "Spreadsheet restored successfully" {
+		t.Errorf("Unexpected result: %v", result)
+	}
+}
+
+func TestFileOps_RefuseNonSpreadsheet(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != "GET" {
+			t.Errorf("Unexpected request for a folder: %s %s", r.Method, r.URL.Path)
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(&drive.File{Id: "folder-id", MimeType: "application/vnd.google-apps.folder"})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	ctx := context.Background()
+
+	calls := map[string]func() (interface{}, error){
+		"copy":   func() (interface{}, error) { return client.CopySpreadsheet(ctx, "folder-id", "Copy", "") },
+		"rename": func() (interface{}, error) { return client.RenameSpreadsheet(ctx, "folder-id", "Renamed") },
+		"move":   func() (interface{}, error) { return client.MoveSpreadsheet(ctx, "folder-id", "new") },
+		"trash":  func() (interface{}, error) { return client.SetTrashed(ctx, "folder-id", true) },
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			_, err := call()
+			if err == nil || !strings.Contains(err.Error(), "not a Google Sheets spreadsheet") {
+				t.Errorf("Expected error for a folder, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// maxPageSize is the largest page the Drive Files API will return
+const maxPageSize = 1000
+
+// fileFields lists the file properties returned by list and search
+const fileFields = "id,name,mimeType,createdTime,modifiedTime,owners(displayName,emailAddress),parents,webViewLink"
+
+// SearchOptions filters a spreadsheet search. Empty fields are not filtered on;
+// MimeType defaults to native Google Sheets files.
+type SearchOptions struct {
+	NameContains  string
+	ModifiedAfter string
+	Owner         string
+	FolderID      string
+	MimeType      string
+	PageSize      int64
+	PageToken     string
+}
+
+// quoteQuery renders a string literal for a Drive query
+func quoteQuery(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
+
+// parseModifiedAfter accepts an RFC 3339 timestamp or a YYYY-MM-DD date and
+// returns it in the UTC form the Drive query language expects
+func parseModifiedAfter(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("invalid modified_after %q (expected RFC 3339 timestamp or YYYY-MM-DD)", value)
+}
+
+// buildQuery turns SearchOptions into a Drive files.list query. Trashed
+// files are always excluded.
+func buildQuery(opts SearchOptions) (string, error) {
+	mimeType := opts.MimeType
+	if mimeType == "" {
+		mimeType = SpreadsheetMimeType
+	}
+
+	clauses := []string{
+		"mimeType = " + quoteQuery(mimeType),
+		"trashed = false",
+	}
+	if opts.NameContains != "" {
+		clauses = append(clauses, "name contains "+quoteQuery(opts.NameContains))
+	}
+	if opts.ModifiedAfter != "" {
+		modified, err := parseModifiedAfter(opts.ModifiedAfter)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, "modifiedTime > "+quoteQuery(modified))
+	}
+	if opts.Owner != "" {
+		clauses = append(clauses, quoteQuery(opts.Owner)+" in owners")
+	}
+	if opts.FolderID != "" {
+		clauses = append(clauses, quoteQuery(opts.FolderID)+" in parents")
+	}
+
+	return strings.Join(clauses, " and "), nil
+}
+
+// fileInfo summarises a Drive file for tool output
+func fileInfo(f *drive.File) map[string]interface{} {
+	info := map[string]interface{}{
+		"id":            f.Id,
+		"name":          f.Name,
+		"mime_type":     f.MimeType,
+		"modified_time": f.ModifiedTime,
+	}
+	if f.CreatedTime != "" {
+		info["created_time"] = f.CreatedTime
+	}
+	if f.WebViewLink != "" {
+		info["web_view_link"] = f.WebViewLink
+	}
+	if len(f.Parents) > 0 {
+		info["parents"] = f.Parents
+	}
+	if len(f.Owners) > 0 {
+		owners := make([]string, 0, len(f.Owners))
+		for _, owner := range f.Owners {
+			if owner.EmailAddress != "" {
+				owners = append(owners, owner.EmailAddress)
+			} else {
+				owners = append(owners, owner.DisplayName)
+			}
+		}
+		info["owners"] = owners
+	}
+	return info
+}
+
+// SearchSpreadsheets finds spreadsheets the user can access, most recently
+// modified first
+func (c *Client) SearchSpreadsheets(ctx context.Context, opts SearchOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if opts.PageSize < 0 || opts.PageSize > maxPageSize {
+		return nil, fmt.Errorf("page_size must be between 1 and %d", maxPageSize)
+	}
+
+	query, err := buildQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	call := c.service.Files.List().
+		Q(query).
+		OrderBy("modifiedTime desc").
+		SupportsAllDrives(true).
+		IncludeItemsFromAllDrives(true).
+		Fields(googleapi.Field("nextPageToken,files(" + fileFields + ")"))
+	if opts.PageSize > 0 {
+		call = call.PageSize(opts.PageSize)
+	}
+	if opts.PageToken != "" {
+		call = call.PageToken(opts.PageToken)
+	}
+
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to search spreadsheets: %v", err)
+	}
+
+	files := make([]map[string]interface{}, len(resp.Files))
+	for i, f := range resp.Files {
+		files[i] = fileInfo(f)
+	}
+
+	result := map[string]interface{}{
+		"spreadsheets": files,
+		"count":        len(files),
+		"query":        query,
+	}
+	if resp.NextPageToken != "" {
+		result["next_page_token"] = resp.NextPageToken
+	}
+	return result, nil
+}
+
+// ListSpreadsheets lists spreadsheets the user can access, optionally only
+// those in a folder
+func (c *Client) ListSpreadsheets(ctx context.Context, folderID string, pageSize int64, pageToken string) (interface{}, error) {
+	return c.SearchSpreadsheets(ctx, SearchOptions{
+		FolderID:  folderID,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+}
@@ -0,0 +1,128 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+)
+
+func TestBuildQuery(t *testing.T) {
+	query, err := buildQuery(SearchOptions{
+		NameContains:  "Q3 'budget'",
+		ModifiedAfter: "2024-01-15",
+		Owner:         "alice@example.com",
+		FolderID:      "folder-1",
+	})
+	if err != nil {
+		t.Fatalf("buildQuery failed: %v", err)
+	}
+
+	expected := "mimeType = 'application/vnd.google-apps.spreadsheet' and trashed = false" +
+		` and name contains 'Q3 \'budget\''` +
+		" and modifiedTime > '2024-01-15T00:00:00Z'" +
+		" and 'alice@example.com' in owners" +
+		" and 'folder-1' in parents"
+	if query != expected {
+		t.Errorf("Unexpected query:\n got: %s\nwant: %s", query, expected)
+	}
+
+	query, err = buildQuery(SearchOptions{MimeType: "text/csv", ModifiedAfter: "2024-01-15T10:00:00+02:00"})
+	if err != nil {
+		t.Fatalf("buildQuery failed: %v", err)
+	}
+	if query != "mimeType = 'text/csv' and trashed = false and modifiedTime > '2024-01-15T08:00:00Z'" {
+		t.Errorf("Unexpected query: %s", query)
+	}
+
+	if _, err := buildQuery(SearchOptions{ModifiedAfter: "last week"}); err == nil {
+		t.Error("Expected error for invalid modified_after")
+	}
+}
+
+func TestSearchSpreadsheets_Success(t *testing.T) {
+	var received url.Values
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&drive.FileList{
+			Files: []*drive.File{
+				{
+					Id:           "sheet-1",
+					Name:         "Budget",
+					MimeType:     SpreadsheetMimeType,
+					ModifiedTime: "2024-02-01T12:00:00.000Z",
+					Owners:       []*drive.User{{DisplayName: "Alice", EmailAddress: "alice@example.com"}},
+				},
+			},
+			NextPageToken: "next",
+		})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	result, err := client.SearchSpreadsheets(context.Background(), SearchOptions{NameContains: "Budget", PageSize: 10})
+	if err != nil {
+		t.Fatalf("SearchSpreadsheets failed: %v", err)
+	}
+
+	if received.Get("q") != "mimeType = 'application/vnd.google-apps.spreadsheet' and trashed = false and name contains 'Budget'" {
+		t.Errorf("Unexpected query: %s", received.Get("q"))
+	}
+	if received.Get("pageSize") != "10" || received.Get("orderBy") != "modifiedTime desc" {
+		t.Errorf("Unexpected list parameters: %v", received)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["count"] != 1 || resultMap["next_page_token"] != "next" {
+		t.Errorf("Unexpected result: %v", resultMap)
+	}
+	file := resultMap["spreadsheets"].([]map[string]interface{})[0]
+	if file["id"] != "sheet-1" || file["owners"].([]string)[0] != "alice@example.com" {
+		t.Errorf("Unexpected file info: %v", file)
+	}
+}
+
+func TestListSpreadsheets_Folder(t *testing.T) {
+	var received url.Values
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&drive.FileList{})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	result, err := client.ListSpreadsheets(context.Background(), "folder-1", 0, "token")
+	if err != nil {
+		t.Fatalf("ListSpreadsheets failed: %v", err)
+	}
+
+	if received.Get("q") != "mimeType = 'application/vnd.google-apps.spreadsheet' and trashed = false and 'folder-1' in parents" {
+		t.Errorf("Unexpected query: %s", received.Get("q"))
+	}
+	if received.Get("pageToken") != "token" || received.Has("pageSize") {
+		t.Errorf("Unexpected list parameters: %v", received)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["count"] != 0 {
+		t.Errorf("Expected no spreadsheets, got %v", resultMap["count"])
+	}
+	if _, ok := resultMap["next_page_token"]; ok {
+		t.Error("Expected no next_page_token on the last page")
+	}
+
+	if _, err := client.ListSpreadsheets(context.Background(), "", maxPageSize+1, ""); err == nil {
+		t.Error("Expected error for oversized page_size")
+	}
+}
@@ -0,0 +1,127 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// paperSizes lists the paper sizes accepted by the PDF exporter
+var paperSizes = []string{"letter", "legal", "tabloid", "statement", "executive", "folio", "A3", "A4", "A5", "B4", "B5"}
+
+// PDFOptions controls the layout of a PDF export. SheetID selects a single
+// sheet (gid), and Range an A1 range on it without the sheet name; by default
+// the whole spreadsheet is exported. Nil Gridlines keeps the exporter's default.
+type PDFOptions struct {
+	SheetID     *int64
+	Range       string
+	Orientation string
+	FitToWidth  bool
+	Gridlines   *bool
+	PaperSize   string
+}
+
+// query renders PDFOptions as export URL parameters
+func (o PDFOptions) query() (url.Values, error) {
+	query := url.Values{}
+
+	if o.SheetID != nil {
+		query.Set("gid", strconv.FormatInt(*o.SheetID, 10))
+	}
+	if o.Range != "" {
+		if o.SheetID == nil {
+			return nil, fmt.Errorf("sheet_id is required when exporting a range")
+		}
+		if strings.Contains(o.Range, "!") {
+			return nil, fmt.Errorf("range %q must not include a sheet name; use sheet_id to choose the sheet", o.Range)
+		}
+		query.Set("range", o.Range)
+	}
+
+	switch strings.ToLower(o.Orientation) {
+	case "", "portrait":
+		query.Set("portrait", "true")
+	case "landscape":
+		query.Set("portrait", "false")
+	default:
+		return nil, fmt.Errorf("invalid orientation %q (expected portrait or landscape)", o.Orientation)
+	}
+
+	if o.FitToWidth {
+		query.Set("fitw", "true")
+	}
+	if o.Gridlines != nil {
+		query.Set("gridlines", strconv.FormatBool(*o.Gridlines))
+	}
+
+	if o.PaperSize != "" {
+		size := ""
+		for _, s := range paperSizes {
+			if strings.EqualFold(s, o.PaperSize) {
+				size = s
+			}
+		}
+		if size == "" {
+			return nil, fmt.Errorf("invalid paper_size %q (expected one of %s)", o.PaperSize, strings.Join(paperSizes, ", "))
+		}
+		query.Set("size", size)
+	}
+
+	return query, nil
+}
+
+// ExportPDF downloads a spreadsheet, or a single sheet or range of it, as a
+// PDF into an allowed export directory
+func (c *Client) ExportPDF(ctx context.Context, spreadsheetID string, opts PDFOptions, outputPath string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	layout, err := opts.query()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := c.service.Files.Get(spreadsheetID).
+		SupportsAllDrives(true).
+		Fields("id,name,exportLinks").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve spreadsheet: %v", err)
+	}
+
+	link, ok := file.ExportLinks[exportFormats["pdf"]]
+	if !ok {
+		return nil, fmt.Errorf("spreadsheet %s cannot be exported as PDF", spreadsheetID)
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("invalid export link: %v", err)
+	}
+	query := u.Query()
+	for key, values := range layout {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+
+	defaultName := spreadsheetID + ".pdf"
+	if opts.SheetID != nil {
+		defaultName = fmt.Sprintf("%s-%d.pdf", spreadsheetID, *opts.SheetID)
+	}
+
+	path, body, err := c.writeExport(ctx, u.String(), outputPath, defaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"spreadsheet_id": spreadsheetID,
+		"name":           file.Name,
+		"path":           path,
+		"bytes":          len(body),
+		"message":        "PDF exported successfully",
+	}, nil
+}
@@ -0,0 +1,102 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+)
+
+func TestPDFOptions_Query(t *testing.T) {
+	gid := int64(7)
+	gridlines := false
+	query, err := PDFOptions{
+		SheetID:     &gid,
+		Range:       "A1:F40",
+		Orientation: "Landscape",
+		FitToWidth:  true,
+		Gridlines:   &gridlines,
+		PaperSize:   "a4",
+	}.query()
+	if err != nil {
+		t.Fatalf("query failed: %v", err)
+	}
+
+	expected := url.Values{
+		"gid":       {"7"},
+		"range":     {"A1:F40"},
+		"portrait":  {"false"},
+		"fitw":      {"true"},
+		"gridlines": {"false"},
+		"size":      {"A4"},
+	}
+	if query.Encode() != expected.Encode() {
+		t.Errorf("Unexpected query: %s", query.Encode())
+	}
+
+	invalid := []PDFOptions{
+		{Range: "A1:B2"},
+		{SheetID: &gid, Range: "Sheet1!A1:B2"},
+		{Orientation: "sideways"},
+		{PaperSize: "napkin"},
+	}
+	for _, opts := range invalid {
+		if _, err := opts.query(); err == nil {
+			t.Errorf("Expected error for %+v", opts)
+		}
+	}
+}
+
+func TestExportPDF_Success(t *testing.T) {
+	var serverURL string
+	var exportQuery url.Values
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/export") {
+			exportQuery = r.URL.Query()
+			w.Write([]byte("%PDF-1.4"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&drive.File{
+			Id:          "sheet-id",
+			Name:        "Monthly Report",
+			ExportLinks: map[string]string{"application/pdf": serverURL + "/export?id=sheet-id&exportFormat=pdf"},
+		})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+	serverURL = server.URL
+
+	client := NewClient(service, server.Client())
+	dir := t.TempDir()
+	client.AllowExportDirs(dir)
+
+	gid := int64(3)
+	result, err := client.ExportPDF(context.Background(), "sheet-id", PDFOptions{SheetID: &gid, FitToWidth: true}, "reports/march.pdf")
+	if err != nil {
+		t.Fatalf("ExportPDF failed: %v", err)
+	}
+
+	if exportQuery.Get("exportFormat") != "pdf" || exportQuery.Get("gid") != "3" || exportQuery.Get("fitw") != "true" || exportQuery.Get("portrait") != "true" {
+		t.Errorf("Unexpected export query: %v", exportQuery)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["path"] != filepath.Join(dir, "reports", "march.pdf") {
+		t.Errorf("Unexpected path: %v", resultMap["path"])
+	}
+	if written, err := os.ReadFile(resultMap["path"].(string)); err != nil || string(written) != "%PDF-1.4" {
+		t.Errorf("Unexpected file contents %q (%v)", written, err)
+	}
+
+	if _, err := client.ExportPDF(context.Background(), "sheet-id", PDFOptions{}, "/etc/report.pdf"); err == nil {
+		t.Error("Expected error for output path outside the export directory")
+	}
+}
@@ -0,0 +1,311 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/drive/v3"
+)
+
+// permissionFields lists the permission properties returned by the sharing tools
+const permissionFields = "id,type,role,emailAddress,domain,displayName"
+
+// ShareOptions describes a new permission on a spreadsheet. EmailAddress is
+// required for user and group permissions and Domain for domain permissions.
+// Notify defaults to true and only applies to users and groups.
+type ShareOptions struct {
+	Type         string
+	Role         string
+	EmailAddress string
+	Domain       string
+	Notify       *bool
+	Message      string
+}
+
+// AllowShareDomains sets the domains that spreadsheets may be shared with.
+// Sharing is refused while the list is empty; "*" allows any domain and is
+// the only way to permit "anyone with the link" access.
+func (c *Client) AllowShareDomains(domains ...string) {
+	c.shareDomains = nil
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain != "" {
+			c.shareDomains = append(c.shareDomains, domain)
+		}
+	}
+}
+
+// domainAllowed reports whether domain is on the sharing allowlist
+func (c *Client) domainAllowed(domain string) bool {
+	domain = strings.ToLower(domain)
+	for _, allowed := range c.shareDomains {
+		if allowed == "*" || allowed == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// checkShareTarget enforces the sharing allowlist for a permission grantee
+func (c *Client) checkShareTarget(permType, email, domain string) error {
+	if len(c.shareDomains) == 0 {
+		return fmt.Errorf("sharing is disabled: no share domains are configured on the server")
+	}
+
+	switch permType {
+	case "user", "group":
+		at := strings.LastIndex(email, "@")
+		if at < 0 {
+			return fmt.Errorf("invalid email address %q", email)
+		}
+		domain = email[at+1:]
+	case "anyone":
+		if !c.domainAllowed("*") {
+			return fmt.Errorf("sharing with anyone is not allowed by the server configuration")
+		}
+		return nil
+	}
+
+	if !c.domainAllowed(domain) {
+		return fmt.Errorf("domain %q is not in the server's share allowlist", domain)
+	}
+	return nil
+}
+
+// validateRole checks a role that may be granted through the sharing tools
+func validateRole(role string) error {
+	switch role {
+	case "reader", "commenter", "writer":
+		return nil
+	default:
+		return fmt.Errorf("invalid role %q (expected reader, commenter or writer)", role)
+	}
+}
+
+// permissionInfo summarises a permission for tool output
+func permissionInfo(p *drive.Permission) map[string]interface{} {
+	info := map[string]interface{}{
+		"permission_id": p.Id,
+		"type":          p.Type,
+		"role":          p.Role,
+	}
+	if p.EmailAddress != "" {
+		info["email_address"] = p.EmailAddress
+	}
+	if p.Domain != "" {
+		info["domain"] = p.Domain
+	}
+	if p.DisplayName != "" {
+		info["display_name"] = p.DisplayName
+	}
+	return info
+}
+
+// permissions fetches every permission on a spreadsheet
+func (c *Client) permissions(ctx context.Context, spreadsheetID string) ([]*drive.Permission, error) {
+	var all []*drive.Permission
+	err := c.service.Permissions.List(spreadsheetID).
+		SupportsAllDrives(true).
+		Fields("nextPageToken,permissions("+permissionFields+")").
+		Pages(ctx, func(page *drive.PermissionList) error {
+			all = append(all, page.Permissions...)
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve permissions: %v", err)
+	}
+	return all, nil
+}
+
+// findPermission finds a permission by ID, email address or domain
+func (c *Client) findPermission(ctx context.Context, spreadsheetID, permission string) (*drive.Permission, error) {
+	all, err := c.permissions(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range all {
+		if p.Id == permission {
+			return p, nil
+		}
+	}
+	for _, p := range all {
+		if strings.EqualFold(p.EmailAddress, permission) || (p.Type == "domain" && strings.EqualFold(p.Domain, permission)) {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("permission %q not found", permission)
+}
+
+// ShareSpreadsheet grants a user, group, domain or anyone access to a
+// spreadsheet, subject to the server's share allowlist
+func (c *Client) ShareSpreadsheet(ctx context.Context, spreadsheetID string, opts ShareOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if err := validateRole(opts.Role); err != nil {
+		return nil, err
+	}
+
+	permission := &drive.Permission{Type: opts.Type, Role: opts.Role}
+	switch opts.Type {
+	case "user", "group":
+		if opts.EmailAddress == "" {
+			return nil, fmt.Errorf("email_address is required for %s permissions", opts.Type)
+		}
+		permission.EmailAddress = opts.EmailAddress
+	case "domain":
+		if opts.Domain == "" {
+			return nil, fmt.Errorf("domain is required for domain permissions")
+		}
+		permission.Domain = opts.Domain
+	case "anyone":
+	default:
+		return nil, fmt.Errorf("invalid type %q (expected user, group, domain or anyone)", opts.Type)
+	}
+
+	if err := c.checkShareTarget(opts.Type, opts.EmailAddress, opts.Domain); err != nil {
+		return nil, err
+	}
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	if dryrun.Enabled(ctx) {
+		details := permissionInfo(permission)
+		delete(details, "permission_id")
+		if opts.Type == "user" || opts.Type == "group" {
+			details["notify"] = opts.Notify == nil || *opts.Notify
+		}
+		return c.preview(ctx, spreadsheetID, "share spreadsheet", details)
+	}
+
+	call := c.service.Permissions.Create(spreadsheetID, permission).
+		SupportsAllDrives(true).
+		Fields(permissionFields)
+	if opts.Type == "user" || opts.Type == "group" {
+		notify := opts.Notify == nil || *opts.Notify
+		call = call.SendNotificationEmail(notify)
+		if notify && opts.Message != "" {
+			call = call.EmailMessage(opts.Message)
+		}
+	}
+
+	created, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to share spreadsheet: %v", err)
+	}
+
+	result := permissionInfo(created)
+	result["message"] = "Spreadsheet shared successfully"
+	return result, nil
+}
+
+// ListPermissions lists who has access to a spreadsheet
+func (c *Client) ListPermissions(ctx context.Context, spreadsheetID string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	all, err := c.permissions(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]map[string]interface{}, len(all))
+	for i, p := range all {
+		permissions[i] = permissionInfo(p)
+	}
+
+	return map[string]interface{}{
+		"permissions": permissions,
+		"count":       len(permissions),
+	}, nil
+}
+
+// UpdatePermission changes the role of an existing permission, identified by
+// permission ID, email address or domain. The grantee must still be allowed
+// by the share allowlist.
+func (c *Client) UpdatePermission(ctx context.Context, spreadsheetID, permission, role string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if err := validateRole(role); err != nil {
+		return nil, err
+	}
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findPermission(ctx, spreadsheetID, permission)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Role == "owner" {
+		return nil, fmt.Errorf("the owner's permission cannot be changed")
+	}
+	if err := c.checkShareTarget(existing.Type, existing.EmailAddress, existing.Domain); err != nil {
+		return nil, err
+	}
+
+	if dryrun.Enabled(ctx) {
+		details := permissionInfo(existing)
+		details["previous_role"] = existing.Role
+		details["role"] = role
+		return c.preview(ctx, spreadsheetID, "update permission", details)
+	}
+
+	updated, err := c.service.Permissions.Update(spreadsheetID, existing.Id, &drive.Permission{Role: role}).
+		SupportsAllDrives(true).
+		Fields(permissionFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to update permission: %v", err)
+	}
+
+	result := permissionInfo(updated)
+	result["previous_role"] = existing.Role
+	result["message"] = "Permission updated successfully"
+	return result, nil
+}
+
+// RevokePermission removes a permission, identified by permission ID, email
+// address or domain
+func (c *Client) RevokePermission(ctx context.Context, spreadsheetID, permission string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.spreadsheetFile(ctx, spreadsheetID, "id"); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findPermission(ctx, spreadsheetID, permission)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Role == "owner" {
+		return nil, fmt.Errorf("the owner's permission cannot be revoked")
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.preview(ctx, spreadsheetID, "revoke permission", permissionInfo(existing))
+	}
+
+	if err := c.service.Permissions.Delete(spreadsheetID, existing.Id).SupportsAllDrives(true).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to revoke permission: %v", err)
+	}
+
+	result := permissionInfo(existing)
+	result["message"] = "Permission revoked successfully"
+	return result, nil
+}
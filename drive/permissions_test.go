@@ -0,0 +1,227 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+)
+
+func TestCheckShareTarget(t *testing.T) {
+	client := NewClient(nil, nil)
+
+	if err := client.checkShareTarget("user", "bob@example.com", ""); err == nil {
+		t.Error("Expected sharing to be disabled without an allowlist")
+	}
+
+	client.AllowShareDomains(" Example.com ", "", "partner.org")
+
+	allowed := []struct{ permType, email, domain string }{
+		{"user", "bob@example.com", ""},
+		{"group", "team@PARTNER.org", ""},
+		{"domain", "", "example.com"},
+	}
+	for _, tc := range allowed {
+		if err := client.checkShareTarget(tc.permType, tc.email, tc.domain); err != nil {
+			t.Errorf("Expected %+v to be allowed, got %v", tc, err)
+		}
+	}
+
+	denied := []struct{ permType, email, domain string }{
+		{"user", "eve@evil.com", ""},
+		{"user", "not-an-email", ""},
+		{"domain", "", "sub.example.com"},
+		{"anyone", "", ""},
+	}
+	for _, tc := range denied {
+		if err := client.checkShareTarget(tc.permType, tc.email, tc.domain); err == nil {
+			t.Errorf("Expected %+v to be denied", tc)
+		}
+	}
+
+	client.AllowShareDomains("*")
+	if err := client.checkShareTarget("anyone", "", ""); err != nil {
+		t.Errorf("Expected anyone to be allowed with wildcard, got %v", err)
+	}
+}
+
+func TestShareSpreadsheet_Success(t *testing.T) {
+	var received drive.Permission
+	var query url.Values
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(&drive.File{Id: "sheet-id", MimeType: SpreadsheetMimeType})
+			return
+		}
+		query = r.URL.Query()
+		json.NewDecoder(r.Body).Decode(&received)
+		received.Id = "perm-1"
+		json.NewEncoder(w).Encode(&received)
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	client.AllowShareDomains("example.com")
+
+	notify := false
+	result, err := client.ShareSpreadsheet(context.Background(), "sheet-id", ShareOptions{
+		Type:         "user",
+		Role:         "commenter",
+		EmailAddress: "bob@example.com",
+		Notify:       &notify,
+		Message:      "ignored",
+	})
+	if err != nil {
+		t.Fatalf("ShareSpreadsheet failed: %v", err)
+	}
+
+	if received.Type != "user" || received.Role != "commenter" || received.EmailAddress != "bob@example.com" {
+		t.Errorf("Unexpected permission: %+v", received)
+	}
+	if query.Get("sendNotificationEmail") != "false" || query.Has("emailMessage") {
+		t.Errorf("Unexpected notification parameters: %v", query)
+	}
+	if result.(map[string]interface{})["permission_id"] != "perm-1" {
+		t.Errorf("Unexpected result: %v", result)
+	}
+}
+
+func TestShareSpreadsheet_Validation(t *testing.T) {
+	service, server := mockDriveService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request expected")
+	}))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	client.AllowShareDomains("example.com")
+
+	invalid := []ShareOptions{
+		{Type: "user", Role: "owner", EmailAddress: "bob@example.com"},
+		{Type: "user", Role: "reader"},
+		{Type: "domain", Role: "reader"},
+		{Type: "robot", Role: "reader"},
+		{Type: "user", Role: "writer", EmailAddress: "eve@evil.com"},
+	}
+	for _, opts := range invalid {
+		if _, err := client.ShareSpreadsheet(context.Background(), "sheet-id", opts); err == nil {
+			t.Errorf("Expected error for %+v", opts)
+		}
+	}
+}
+
+// permissionsHandler serves a spreadsheet with a fixed permission list and
+// records updates and deletes
+func permissionsHandler(onChange func(r *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" && !strings.HasSuffix(r.URL.Path, "/permissions") {
+			json.NewEncoder(w).Encode(&drive.File{Id: "sheet-id", MimeType: SpreadsheetMimeType})
+			return
+		}
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(&drive.PermissionList{
+				Permissions: []*drive.Permission{
+					{Id: "owner-1", Type: "user", Role: "owner", EmailAddress: "me@example.com"},
+					{Id: "perm-1", Type: "user", Role: "reader", EmailAddress: "bob@example.com"},
+					{Id: "perm-2", Type: "domain", Role: "reader", Domain: "partner.org"},
+				},
+			})
+			return
+		}
+		onChange(r)
+		var p drive.Permission
+		json.NewDecoder(r.Body).Decode(&p)
+		p.Id = strings.TrimPrefix(r.URL.Path[strings.LastIndex(r.URL.Path, "/"):], "/")
+		json.NewEncoder(w).Encode(&p)
+	}
+}
+
+func TestUpdatePermission_ByEmail(t *testing.T) {
+	var path string
+	service, server := mockDriveService(t, permissionsHandler(func(r *http.Request) { path = r.URL.Path }))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	client.AllowShareDomains("example.com")
+
+	result, err := client.UpdatePermission(context.Background(), "sheet-id", "BOB@example.com", "writer")
+	if err != nil {
+		t.Fatalf("UpdatePermission failed: %v", err)
+	}
+
+	if !strings.HasSuffix(path, "/permissions/perm-1") {
+		t.Errorf("Unexpected request path: %s", path)
+	}
+	resultMap := result.(map[string]interface{})
+	if resultMap["role"] != "writer" || resultMap["previous_role"] != "reader" {
+		t.Errorf("Unexpected result: %v", resultMap)
+	}
+
+	if _, err := client.UpdatePermission(context.Background(), "sheet-id", "partner.org", "writer"); err == nil {
+		t.Error("Expected error when the grantee is outside the allowlist")
+	}
+	if _, err := client.UpdatePermission(context.Background(), "sheet-id", "owner-1", "reader"); err == nil {
+		t.Error("Expected error when changing the owner")
+	}
+}
+
+func TestRevokePermission_Success(t *testing.T) {
+	var method, path string
+	service, server := mockDriveService(t, permissionsHandler(func(r *http.Request) { method, path = r.Method, r.URL.Path }))
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	if _, err := client.RevokePermission(context.Background(), "sheet-id", "partner.org"); err != nil {
+		t.Fatalf("RevokePermission failed: %v", err)
+	}
+	if method != "DELETE" || !strings.HasSuffix(path, "/permissions/perm-2") {
+		t.Errorf("Unexpected request: %s %s", method, path)
+	}
+
+	if _, err := client.RevokePermission(context.Background(), "sheet-id", "nobody@example.com"); err == nil {
+		t.Error("Expected error for unknown permission")
+	}
+}
+
+func TestPermissions_RefuseNonSpreadsheet(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != "GET" || strings.Contains(r.URL.Path, "/permissions") {
+			t.Errorf("Unexpected request for a folder: %s %s", r.Method, r.URL.Path)
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(&drive.File{Id: "folder-id", MimeType: "application/vnd.google-apps.folder"})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+	client.AllowShareDomains("example.com")
+
+	_, err := client.ShareSpreadsheet(context.Background(), "folder-id", ShareOptions{Type: "user", Role: "reader", EmailAddress: "bob@example.com"})
+	if err == nil || !strings.Contains(err.Error(), "not a Google Sheets spreadsheet") {
+		t.Errorf("Expected share of a folder to be refused, got %v", err)
+	}
+	_, err = client.UpdatePermission(context.Background(), "folder-id", "bob@example.com", "writer")
+	if err == nil || !strings.Contains(err.Error(), "not a Google Sheets spreadsheet") {
+		t.Errorf("Expected permission update on a folder to be refused, got %v", err)
+	}
+	_, err = client.RevokePermission(context.Background(), "folder-id", "bob@example.com")
+	if err == nil || !strings.Contains(err.Error(), "not a Google Sheets spreadsheet") {
+		t.Errorf("Expected permission revoke on a folder to be refused, got %v", err)
+	}
+	_, err = client.ListPermissions(context.Background(), "folder-id")
+	if err == nil || !strings.Contains(err.Error(), "not a Google Sheets spreadsheet") {
+		t.Errorf("Expected permission list on a folder to be refused, got %v", err)
+	}
+}
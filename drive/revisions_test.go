@@ -0,0 +1,100 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+)
+
+func TestListRevisions_Success(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&drive.RevisionList{
+			Revisions: []*drive.Revision{
+				{Id: "1", ModifiedTime: "2024-03-01T09:00:00.000Z", LastModifyingUser: &drive.User{DisplayName: "Alice", EmailAddress: "alice@example.com"}},
+				{Id: "2", ModifiedTime: "2024-03-02T09:00:00.000Z"},
+			},
+		})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service, server.Client())
+
+	result, err := client.ListRevisions(context.Background(), "sheet-id")
+	if err != nil {
+		t.Fatalf("ListRevisions failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["count"] != 2 {
+		t.Fatalf("Expected 2 revisions, got %v", resultMap["count"])
+	}
+	first := resultMap["revisions"].([]map[string]interface{})[0]
+	if first["revision_id"] != "1" || first["author"] != "Alice" || first["author_email"] != "alice@example.com" {
+		t.Errorf("Unexpected revision info: %v", first)
+	}
+}
+
+func TestExportRevision_CSV(t *testing.T) {
+	var serverURL, exportQuery string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/export") {
+			exportQuery = r.URL.RawQuery
+			w.Write([]byte("Name,Age\nAlice,30\n"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&drive.Revision{
+			Id:           "7",
+			ModifiedTime: "2024-03-01T09:00:00.000Z",
+			ExportLinks: map[string]string{
+				"text/csv": serverURL + "/export?id=sheet-id&revision=7&exportFormat=csv",
+			},
+		})
+	})
+
+	service, server := mockDriveService(t, handler)
+	defer server.Close()
+	serverURL = server.URL
+
+	client := NewClient(service, server.Client())
+	dir := t.TempDir()
+	client.AllowExportDirs(dir)
+
+	gid := int64(42)
+	result, err := client.ExportRevision(context.Background(), "sheet-id", "7", "", &gid, "")
+	if err != nil {
+		t.Fatalf("ExportRevision failed: %v", err)
+	}
+
+	if !strings.Contains(exportQuery, "gid=42") || !strings.Contains(exportQuery, "revision=7") {
+		t.Errorf("Unexpected export query: %s", exportQuery)
+	}
+
+	resultMap := result.(map[string]interface{})
+	values := resultMap["values"].([][]string)
+	if len(values) != 2 || values[1][0] != "Alice" {
+		t.Errorf("Unexpected values: %v", values)
+	}
+	written, err := os.ReadFile(resultMap["path"].(string))
+	if err != nil || string(written) != "Name,Age\nAlice,30\n" {
+		t.Errorf("Unexpected file contents %q (%v)", written, err)
+	}
+
+	if _, err := client.ExportRevision(context.Background(), "sheet-id", "7", "xlsx", nil, ""); err == nil {
+		t.Error("Expected error when the revision has no xlsx export link")
+	}
+	if _, err := client.ExportRevision(context.Background(), "sheet-id", "7", "xlsx", &gid, ""); err == nil {
+		t.Error("Expected error for sheet_id with xlsx")
+	}
+	if _, err := client.ExportRevision(context.Background(), "sheet-id", "7", "ods", nil, ""); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
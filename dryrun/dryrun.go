@@ -0,0 +1,23 @@
+// Package dryrun marks requests whose changes should be validated and
+// reported but not applied.
+package dryrun
+
+import "context"
+
+// Message is returned with every preview so it cannot be mistaken for a
+// completed change
+const Message = "Dry run: no changes were made"
+
+// contextKey keys the dry-run marker in a context
+type contextKey struct{}
+
+// With returns a context in which mutating calls only report what they would change
+func With(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, true)
+}
+
+// Enabled reports whether ctx was marked with With
+func Enabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(contextKey{}).(bool)
+	return enabled
+}
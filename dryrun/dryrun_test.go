@@ -0,0 +1,24 @@
+package dryrun
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEnabled(t *testing.T) {
+	ctx := context.Background()
+	if Enabled(ctx) {
+		t.Error("Expected a plain context not to be in dry-run mode")
+	}
+
+	dry := With(ctx)
+	if !Enabled(dry) {
+		t.Error("Expected With to enable dry-run mode")
+	}
+
+	child, cancel := context.WithCancel(dry)
+	defer cancel()
+	if !Enabled(child) {
+		t.Error("Expected derived contexts to stay in dry-run mode")
+	}
+}
@@ -8,9 +8,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/conallob/mcp-google-sheets/drive"
+	"github.com/conallob/mcp-google-sheets/dryrun"
 	"github.com/conallob/mcp-google-sheets/oauth"
 	"github.com/conallob/mcp-google-sheets/sheets"
+	driveapi "google.golang.org/api/drive/v3"
 	"google.golang.org/api/option"
 	sheetsapi "google.golang.org/api/sheets/v4"
 )
@@ -18,8 +23,82 @@ import (
 const (
 	serverName    = "mcp-google-sheets"
 	serverVersion = "1.0.0"
+
+	// shareDomainsEnv lists the comma-separated domains spreadsheets may be shared with
+	shareDomainsEnv = "MCP_GOOGLE_SHEETS_SHARE_DOMAINS"
+	// exportDirsEnv lists the local directories exports may be written to,
+	// separated like PATH
+	exportDirsEnv = "MCP_GOOGLE_SHEETS_EXPORT_DIRS"
 )
 
+// mutatingTools lists the tools that change spreadsheets or their files. Each
+// accepts a dry_run argument, and all of them only report the changes they
+// would make when the server is started with --dry-run.
+var mutatingTools = map[string]bool{
+	"write_sheet":               true,
+	"append_sheet":              true,
+	"create_spreadsheet":        true,
+	"add_sheet":                 true,
+	"clear_sheet":               true,
+	"batch_update":              true,
+	"create_chart":              true,
+	"update_chart":              true,
+	"delete_chart":              true,
+	"create_pivot_table":        true,
+	"create_named_range":        true,
+	"update_named_range":        true,
+	"delete_named_range":        true,
+	"add_protected_range":       true,
+	"update_protected_range":    true,
+	"delete_protected_range":    true,
+	"delete_sheet":              true,
+	"rename_sheet":              true,
+	"duplicate_sheet":           true,
+	"move_sheet":                true,
+	"set_sheet_hidden":          true,
+	"set_tab_color":             true,
+	"insert_dimension":          true,
+	"delete_dimension":          true,
+	"move_dimension":            true,
+	"resize_dimension":          true,
+	"auto_resize":               true,
+	"freeze_panes":              true,
+	"merge_cells":               true,
+	"unmerge_cells":             true,
+	"update_grid_properties":    true,
+	"find_replace":              true,
+	"sort_range":                true,
+	"set_basic_filter":          true,
+	"clear_basic_filter":        true,
+	"create_filter_view":        true,
+	"update_filter_view":        true,
+	"delete_filter_view":        true,
+	"create_slicer":             true,
+	"update_slicer":             true,
+	"delete_slicer":             true,
+	"set_note":                  true,
+	"clear_notes":               true,
+	"set_hyperlink":             true,
+	"add_banding":               true,
+	"update_banding":            true,
+	"delete_banding":            true,
+	"create_developer_metadata": true,
+	"delete_developer_metadata": true,
+	"copy_sheet_to":             true,
+	"copy_spreadsheet":          true,
+	"rename_spreadsheet":        true,
+	"move_spreadsheet":          true,
+	"trash_spreadsheet":         true,
+	"restore_spreadsheet":       true,
+	"share_spreadsheet":         true,
+	"update_permission":         true,
+	"revoke_permission":         true,
+	"create_comment":            true,
+	"reply_to_comment":          true,
+	"resolve_comment":           true,
+	"create_from_template":      true,
+}
+
 type MCPRequest struct {
 	JSONRPC string          `json:"jsonrpc"`
 	ID      interface{}     `json:"id,omitempty"`
@@ -42,6 +121,7 @@ type MCPError struct {
 
 type MCPServer struct {
 	sheetsClient *sheets.Client
+	driveClient  *drive.Client
 	ctx          context.Context
 }
 
@@ -64,12 +144,33 @@ func NewMCPServer(ctx context.Context) (*MCPServer, error) {
 		return nil, fmt.Errorf("unable to create sheets service: %v", err)
 	}
 
+	// Create Drive service for finding and managing spreadsheet files
+	driveSrv, err := driveapi.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create drive service: %v", err)
+	}
+
+	// Sharing is only permitted with domains listed in the environment
+	driveClient := drive.NewClient(driveSrv, client)
+	driveClient.AllowShareDomains(strings.Split(os.Getenv(shareDomainsEnv), ",")...)
+	driveClient.AllowExportDirs(exportDirs()...)
+
 	return &MCPServer{
 		sheetsClient: sheets.NewClient(srv),
+		driveClient:  driveClient,
 		ctx:          ctx,
 	}, nil
 }
 
+// exportDirs returns the directories exports may be written to, defaulting to
+// a directory under the system temp directory
+func exportDirs() []string {
+	if dirs := os.Getenv(exportDirsEnv); dirs != "" {
+		return filepath.SplitList(dirs)
+	}
+	return []string{filepath.Join(os.TempDir(), serverName)}
+}
+
 func (s *MCPServer) handleRequest(req MCPRequest) MCPResponse {
 	switch req.Method {
 	case "initialize":
@@ -127,7 +228,11 @@ func (s *MCPServer) handleToolsList(req MCPRequest) MCPResponse {
 					},
 					"range": map[string]interface{}{
 						"type":        "string",
-						"description": "The A1 notation range to read (e.g., 'Sheet1!A1:D10'). Optional - defaults to entire first sheet.",
+						"description": "The A1 notation range or named range to read (e.g., 'Sheet1!A1:D10'). Optional - defaults to entire first sheet.",
+					},
+					"include_notes": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Also return cell notes and hyperlinks, with their cell addresses (default: false)",
 					},
 				},
 				"required": []string{"spreadsheet_id"},
@@ -145,7 +250,7 @@ func (s *MCPServer) handleToolsList(req MCPRequest) MCPResponse {
 					},
 					"range": map[string]interface{}{
 						"type":        "string",
-						"description": "The A1 notation range to write to (e.g., 'Sheet1!A1:D10')",
+						"description": "The A1 notation range or named range to write to (e.g., 'Sheet1!A1:D10')",
 					},
 					"values": map[string]interface{}{
 						"type":        "array",
@@ -173,7 +278,7 @@ func (s *MCPServer) handleToolsList(req MCPRequest) MCPResponse {
 					},
 					"range": map[string]interface{}{
 						"type":        "string",
-						"description": "The A1 notation range (e.g., 'Sheet1!A:D' or 'Sheet1')",
+						"description": "The A1 notation range or named range (e.g., 'Sheet1!A:D' or 'Sheet1')",
 					},
 					"values": map[string]interface{}{
 						"type":        "array",
@@ -191,7 +296,7 @@ func (s *MCPServer) handleToolsList(req MCPRequest) MCPResponse {
 		},
 		{
 			"name":        "create_spreadsheet",
-			"description": "Create a new Google Spreadsheet with the specified title and optional sheet names.",
+			"description": "Create a new Google Spreadsheet with the specified title and optional sheets. Sheets can be given as names, or as objects with grid size, frozen rows, tab color, initial values and a header row style, so one call yields a ready-to-use workbook.",
 			"inputSchema": map[string]interface{}{
 				"type": "object",
 				"properties": map[string]interface{}{
@@ -199,11 +304,75 @@ func (s *MCPServer) handleToolsList(req MCPRequest) MCPResponse {
 						"type":        "string",
 						"description": "The title of the new spreadsheet",
 					},
+					"locale": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional locale for number and date formats (e.g., 'en_GB', 'de_DE')",
+					},
+					"time_zone": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional time zone in IANA format (e.g., 'Europe/Dublin')",
+					},
 					"sheets": map[string]interface{}{
 						"type":        "array",
-						"description": "Optional array of sheet names to create. If not provided, creates one default sheet.",
+						"description": "Optional array of sheets to create, each a sheet name or a sheet object. If not provided, creates one default sheet.",
 						"items": map[string]interface{}{
-							"type": "string",
+							"anyOf": []map[string]interface{}{
+								{"type": "string"},
+								{
+									"type": "object",
+									"properties": map[string]interface{}{
+										"title": map[string]interface{}{
+											"type":        "string",
+											"description": "Sheet name",
+										},
+										"row_count": map[string]interface{}{
+											"type":        "integer",
+											"description": "Number of rows (default 1000, grown to fit values)",
+										},
+										"column_count": map[string]interface{}{
+											"type":        "integer",
+											"description": "Number of columns (default 26, grown to fit values)",
+										},
+										"frozen_rows": map[string]interface{}{
+											"type":        "integer",
+											"description": "Number of rows to freeze at the top",
+										},
+										"tab_color": map[string]interface{}{
+											"type":        "string",
+											"description": "Tab color as hex (e.g., '#4285F4')",
+										},
+										"values": map[string]interface{}{
+											"type":        "array",
+											"description": "Initial values starting at A1, parsed as if typed by a user (formulas, numbers, dates)",
+											"items": map[string]interface{}{
+												"type": "array",
+												"items": map[string]interface{}{
+													"type": "string",
+												},
+											},
+										},
+										"header_style": map[string]interface{}{
+											"type":        "object",
+											"description": "Format the first row of values as a header. Bold by default.",
+											"properties": map[string]interface{}{
+												"bold": map[string]interface{}{
+													"type":        "boolean",
+													"description": "Bold header text (default: true)",
+												},
+												"background_color": map[string]interface{}{
+													"type":        "string",
+													"description": "Header background color as hex",
+												},
+												"text_color": map[string]interface{}{
+													"type":        "string",
+													"description": "Header text color as hex",
+												},
+											},
+										},
+									},
+									"required": []string{"title"},
+								},
+							},
 						},
 					},
 				},
@@ -254,7 +423,7 @@ func (s *MCPServer) handleToolsList(req MCPRequest) MCPResponse {
 					},
 					"range": map[string]interface{}{
 						"type":        "string",
-						"description": "The A1 notation range to clear (e.g., 'Sheet1!A1:D10' or 'Sheet1')",
+						"description": "The A1 notation range or named range to clear (e.g., 'Sheet1!A1:D10' or 'Sheet1')",
 					},
 				},
 				"required": []string{"spreadsheet_id", "range"},
@@ -278,183 +447,3474 @@ func (s *MCPServer) handleToolsList(req MCPRequest) MCPResponse {
 				"required": []string{"spreadsheet_id", "requests"},
 			},
 		},
-	}
-
-	return MCPResponse{
-		JSONRPC: "2.0",
-		ID:      req.ID,
-		Result: map[string]interface{}{
-			"tools": tools,
+		{
+			"name":        "create_chart",
+			"description": "Create a chart from sheet data. Specify the chart type, the domain (x-axis/labels) range and one or more series ranges. The chart is anchored at a cell or placed on a new sheet.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"chart_type": map[string]interface{}{
+						"type":        "string",
+						"description": "The chart type",
+						"enum":        []string{"line", "bar", "column", "area", "pie", "scatter"},
+					},
+					"domain_range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range for the domain/labels, including the header row (e.g., 'Sheet1!A1:A10')",
+					},
+					"series_ranges": map[string]interface{}{
+						"type":        "array",
+						"description": "A1 notation ranges for each data series, including the header row (e.g., ['Sheet1!B1:B10']). Pie charts use only the first series.",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"title": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional chart title",
+					},
+					"anchor_cell": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional cell where the chart's top-left corner is placed (e.g., 'Sheet1!E2'). Defaults to the sheet containing the data.",
+					},
+					"new_sheet": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Place the chart on its own new sheet instead of over the data",
+					},
+				},
+				"required": []string{"spreadsheet_id", "chart_type", "domain_range", "series_ranges"},
+			},
 		},
-	}
-}
-
-func (s *MCPServer) handleToolsCall(req MCPRequest) MCPResponse {
-	var params struct {
-		Name      string          `json:"name"`
-		Arguments json.RawMessage `json:"arguments"`
-	}
-
-	if err := json.Unmarshal(req.Params, &params); err != nil {
-		return MCPResponse{
-			JSONRPC: "2.0",
-			ID:      req.ID,
-			Error: &MCPError{
-				Code:    -32602,
-				Message: "Invalid params",
-				Data:    err.Error(),
+		{
+			"name":        "update_chart",
+			"description": "Update an existing chart's title, type, data ranges or position. Use get_spreadsheet_info to find chart IDs. Changing to or from a pie chart requires domain_range and series_ranges.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"chart_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "The ID of the chart to update",
+					},
+					"chart_type": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional new chart type",
+						"enum":        []string{"line", "bar", "column", "area", "pie", "scatter"},
+					},
+					"domain_range": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional new A1 notation range for the domain/labels",
+					},
+					"series_ranges": map[string]interface{}{
+						"type":        "array",
+						"description": "Optional new A1 notation ranges for the data series",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"title": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional new chart title",
+					},
+					"anchor_cell": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional new anchor cell for the chart (e.g., 'Sheet1!E2')",
+					},
+					"new_sheet": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Move the chart to its own new sheet",
+					},
+				},
+				"required": []string{"spreadsheet_id", "chart_id"},
 			},
-		}
-	}
-
-	var result interface{}
-	var err error
-
-	switch params.Name {
-	case "read_sheet":
-		result, err = s.handleReadSheet(params.Arguments)
-	case "write_sheet":
-		result, err = s.handleWriteSheet(params.Arguments)
-	case "append_sheet":
-		result, err = s.handleAppendSheet(params.Arguments)
-	case "create_spreadsheet":
-		result, err = s.handleCreateSpreadsheet(params.Arguments)
-	case "get_spreadsheet_info":
-		result, err = s.handleGetSpreadsheetInfo(params.Arguments)
-	case "add_sheet":
-		result, err = s.handleAddSheet(params.Arguments)
-	case "clear_sheet":
-		result, err = s.handleClearSheet(params.Arguments)
-	case "batch_update":
-		result, err = s.handleBatchUpdate(params.Arguments)
-	default:
-		return MCPResponse{
-			JSONRPC: "2.0",
-			ID:      req.ID,
-			Error: &MCPError{
-				Code:    -32601,
-				Message: fmt.Sprintf("Tool not found: %s", params.Name),
+		},
+		{
+			"name":        "delete_chart",
+			"description": "Delete a chart from a spreadsheet. Use get_spreadsheet_info to find chart IDs.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"chart_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "The ID of the chart to delete",
+					},
+				},
+				"required": []string{"spreadsheet_id", "chart_id"},
 			},
-		}
-	}
-
-	if err != nil {
-		return MCPResponse{
-			JSONRPC: "2.0",
-			ID:      req.ID,
-			Error: &MCPError{
-				Code:    -32000,
-				Message: err.Error(),
+		},
+		{
+			"name":        "create_pivot_table",
+			"description": "Create a pivot table summarising a source range. Row groups, column groups, values and filters reference columns by their header name in the first row of the source range.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"source_range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range of the source data, including the header row (e.g., 'Sales!A1:F500')",
+					},
+					"target_cell": map[string]interface{}{
+						"type":        "string",
+						"description": "Cell where the pivot table's top-left corner is written (e.g., 'Summary!A1')",
+					},
+					"rows": map[string]interface{}{
+						"type":        "array",
+						"description": "Header names to group rows by (e.g., ['Region'])",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"columns": map[string]interface{}{
+						"type":        "array",
+						"description": "Header names to group columns by (e.g., ['Quarter'])",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"values": map[string]interface{}{
+						"type":        "array",
+						"description": "Values to summarise",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"column": map[string]interface{}{
+									"type":        "string",
+									"description": "Header name of the column to summarise",
+								},
+								"function": map[string]interface{}{
+									"type":        "string",
+									"description": "Aggregation: SUM, COUNTA, COUNT, COUNTUNIQUE, AVERAGE, MAX, MIN, MEDIAN, PRODUCT, STDEV, STDEVP, VAR or VARP. Defaults to SUM.",
+								},
+								"name": map[string]interface{}{
+									"type":        "string",
+									"description": "Optional display name for the value",
+								},
+							},
+							"required": []string{"column"},
+						},
+					},
+					"filters": map[string]interface{}{
+						"type":        "array",
+						"description": "Optional filters on source rows",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"column": map[string]interface{}{
+									"type":        "string",
+									"description": "Header name of the column to filter on",
+								},
+								"visible_values": map[string]interface{}{
+									"type":        "array",
+									"description": "Only include rows whose value is one of these",
+									"items": map[string]interface{}{
+										"type": "string",
+									},
+								},
+								"condition": map[string]interface{}{
+									"type":        "string",
+									"description": "Condition type, e.g. NUMBER_GREATER, TEXT_CONTAINS, DATE_AFTER",
+								},
+								"condition_values": map[string]interface{}{
+									"type":        "array",
+									"description": "Values for the condition",
+									"items": map[string]interface{}{
+										"type": "string",
+									},
+								},
+							},
+							"required": []string{"column"},
+						},
+					},
+				},
+				"required": []string{"spreadsheet_id", "source_range", "target_cell", "values"},
 			},
-		}
-	}
-
-	return MCPResponse{
-		JSONRPC: "2.0",
-		ID:      req.ID,
-		Result: map[string]interface{}{
-			"content": []map[string]interface{}{
-				{
-					"type": "text",
-					"text": fmt.Sprintf("%v", result),
+		},
+		{
+			"name":        "list_named_ranges",
+			"description": "List the named ranges defined in a spreadsheet with their IDs and A1 ranges.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
 				},
+				"required": []string{"spreadsheet_id"},
 			},
 		},
-	}
-}
-
-func (s *MCPServer) handleReadSheet(args json.RawMessage) (interface{}, error) {
-	var params struct {
-		SpreadsheetID string `json:"spreadsheet_id"`
-		Range         string `json:"range,omitempty"`
-	}
-	if err := json.Unmarshal(args, &params); err != nil {
-		return nil, err
-	}
-	return s.sheetsClient.ReadSheet(s.ctx, params.SpreadsheetID, params.Range)
-}
-
-func (s *MCPServer) handleWriteSheet(args json.RawMessage) (interface{}, error) {
-	var params struct {
-		SpreadsheetID string     `json:"spreadsheet_id"`
-		Range         string     `json:"range"`
-		Values        [][]string `json:"values"`
-	}
-	if err := json.Unmarshal(args, &params); err != nil {
-		return nil, err
-	}
-	return s.sheetsClient.WriteSheet(s.ctx, params.SpreadsheetID, params.Range, params.Values)
-}
-
-func (s *MCPServer) handleAppendSheet(args json.RawMessage) (interface{}, error) {
-	var params struct {
-		SpreadsheetID string     `json:"spreadsheet_id"`
-		Range         string     `json:"range"`
-		Values        [][]string `json:"values"`
-	}
-	if err := json.Unmarshal(args, &params); err != nil {
-		return nil, err
-	}
-	return s.sheetsClient.AppendSheet(s.ctx, params.SpreadsheetID, params.Range, params.Values)
-}
-
-func (s *MCPServer) handleCreateSpreadsheet(args json.RawMessage) (interface{}, error) {
-	var params struct {
-		Title  string   `json:"title"`
-		Sheets []string `json:"sheets,omitempty"`
-	}
-	if err := json.Unmarshal(args, &params); err != nil {
-		return nil, err
-	}
-	return s.sheetsClient.CreateSpreadsheet(s.ctx, params.Title, params.Sheets)
-}
-
-func (s *MCPServer) handleGetSpreadsheetInfo(args json.RawMessage) (interface{}, error) {
-	var params struct {
-		SpreadsheetID string `json:"spreadsheet_id"`
-	}
-	if err := json.Unmarshal(args, &params); err != nil {
-		return nil, err
-	}
-	return s.sheetsClient.GetSpreadsheetInfo(s.ctx, params.SpreadsheetID)
-}
-
-func (s *MCPServer) handleAddSheet(args json.RawMessage) (interface{}, error) {
-	var params struct {
-		SpreadsheetID string `json:"spreadsheet_id"`
-		SheetName     string `json:"sheet_name"`
-	}
-	if err := json.Unmarshal(args, &params); err != nil {
-		return nil, err
-	}
-	return s.sheetsClient.AddSheet(s.ctx, params.SpreadsheetID, params.SheetName)
-}
+		{
+			"name":        "create_named_range",
+			"description": "Create a named range. Named ranges can then be used in place of A1 notation in any range parameter.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "Name for the range (letters, digits and underscores, not an A1 reference)",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "The A1 notation range to name (e.g., 'Sheet1!A1:D10')",
+					},
+				},
+				"required": []string{"spreadsheet_id", "name", "range"},
+			},
+		},
+		{
+			"name":        "update_named_range",
+			"description": "Rename a named range and/or change the cells it covers.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"named_range": map[string]interface{}{
+						"type":        "string",
+						"description": "Current name or ID of the named range",
+					},
+					"new_name": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional new name",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional new A1 notation range",
+					},
+				},
+				"required": []string{"spreadsheet_id", "named_range"},
+			},
+		},
+		{
+			"name":        "delete_named_range",
+			"description": "Delete a named range. The cell contents are not affected.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"named_range": map[string]interface{}{
+						"type":        "string",
+						"description": "Name or ID of the named range",
+					},
+				},
+				"required": []string{"spreadsheet_id", "named_range"},
+			},
+		},
+		{
+			"name":        "add_protected_range",
+			"description": "Protect a range or a whole sheet from edits. Protection can restrict editing to listed editors or only show a warning when edited.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range to protect (e.g., 'Sheet1!1:1'). Provide either range or sheet.",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or ID of a sheet to protect entirely. Provide either range or sheet.",
+					},
+					"unprotected_ranges": map[string]interface{}{
+						"type":        "array",
+						"description": "Ranges left editable within a protected sheet",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional description of the protection",
+					},
+					"warning_only": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Show a warning on edit instead of blocking it. Cannot be combined with editors.",
+					},
+					"editors": map[string]interface{}{
+						"type":        "array",
+						"description": "Email addresses of users allowed to edit",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"groups": map[string]interface{}{
+						"type":        "array",
+						"description": "Email addresses of groups allowed to edit",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"domain_users_can_edit": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Allow anyone in the document's domain to edit",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "list_protected_ranges",
+			"description": "List protected ranges and protected sheets with their editors and settings.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional sheet title or ID to limit the listing to",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "update_protected_range",
+			"description": "Update a protected range. Only the options provided are changed.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"protected_range_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "The ID of the protected range (from list_protected_ranges)",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional new A1 notation range to protect",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional sheet title or ID to protect entirely instead",
+					},
+					"unprotected_ranges": map[string]interface{}{
+						"type":        "array",
+						"description": "Optional new list of ranges left editable within a protected sheet",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional new description",
+					},
+					"warning_only": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Optional warning-only setting",
+					},
+					"editors": map[string]interface{}{
+						"type":        "array",
+						"description": "Optional new list of user editors (replaces the existing list)",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"groups": map[string]interface{}{
+						"type":        "array",
+						"description": "Optional new list of group editors (replaces the existing list)",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"domain_users_can_edit": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Optional domain edit setting",
+					},
+				},
+				"required": []string{"spreadsheet_id", "protected_range_id"},
+			},
+		},
+		{
+			"name":        "delete_protected_range",
+			"description": "Remove protection from a range or sheet.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"protected_range_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "The ID of the protected range (from list_protected_ranges)",
+					},
+				},
+				"required": []string{"spreadsheet_id", "protected_range_id"},
+			},
+		},
+		{
+			"name":        "delete_sheet",
+			"description": "Delete a sheet (tab) and all of its data from a spreadsheet.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet to delete",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet"},
+			},
+		},
+		{
+			"name":        "rename_sheet",
+			"description": "Rename a sheet (tab).",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet to rename",
+					},
+					"new_title": map[string]interface{}{
+						"type":        "string",
+						"description": "The new sheet title",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet", "new_title"},
+			},
+		},
+		{
+			"name":        "duplicate_sheet",
+			"description": "Duplicate a sheet (tab) within the same spreadsheet, including its data and formatting.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet to duplicate",
+					},
+					"new_title": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional title for the copy. Defaults to 'Copy of <title>'.",
+					},
+					"index": map[string]interface{}{
+						"type":        "integer",
+						"description": "Optional zero-based tab position for the copy. Defaults to right after the original.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet"},
+			},
+		},
+		{
+			"name":        "move_sheet",
+			"description": "Move a sheet (tab) to a new position.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet to move",
+					},
+					"index": map[string]interface{}{
+						"type":        "integer",
+						"description": "Zero-based tab position to move the sheet to",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet", "index"},
+			},
+		},
+		{
+			"name":        "set_sheet_hidden",
+			"description": "Hide or unhide a sheet (tab).",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet",
+					},
+					"hidden": map[string]interface{}{
+						"type":        "boolean",
+						"description": "True to hide the sheet, false to unhide it",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet", "hidden"},
+			},
+		},
+		{
+			"name":        "set_tab_color",
+			"description": "Set or clear the tab color of a sheet.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet",
+					},
+					"color": map[string]interface{}{
+						"type":        "string",
+						"description": "Hex color (e.g., '#1A73E8'). Omit or leave empty to clear the tab color.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet"},
+			},
+		},
+		{
+			"name":        "insert_dimension",
+			"description": "Insert empty rows or columns, shifting existing ones down or right. The new rows or columns occupy start through end.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet. Defaults to the first sheet.",
+					},
+					"dimension": map[string]interface{}{
+						"type":        "string",
+						"description": "Whether to operate on rows or columns",
+						"enum":        []string{"ROWS", "COLUMNS"},
+					},
+					"start": map[string]interface{}{
+						"type":        "string",
+						"description": "First new row number (1-based, e.g., '3') or column letter (e.g., 'C')",
+					},
+					"end": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional last row number or column letter, inclusive. Defaults to start.",
+					},
+					"inherit_from_before": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Copy formatting from the row or column before the insertion point instead of after it (default: false)",
+					},
+				},
+				"required": []string{"spreadsheet_id", "dimension", "start"},
+			},
+		},
+		{
+			"name":        "delete_dimension",
+			"description": "Delete rows or columns and their contents.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet",
+					},
+					"dimension": map[string]interface{}{
+						"type":        "string",
+						"description": "Whether to operate on rows or columns",
+						"enum":        []string{"ROWS", "COLUMNS"},
+					},
+					"start": map[string]interface{}{
+						"type":        "string",
+						"description": "First row number (1-based, e.g., '3') or column letter (e.g., 'C')",
+					},
+					"end": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional last row number or column letter, inclusive. Defaults to start.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet", "dimension", "start"},
+			},
+		},
+		{
+			"name":        "move_dimension",
+			"description": "Move rows or columns to a new position.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet",
+					},
+					"dimension": map[string]interface{}{
+						"type":        "string",
+						"description": "Whether to operate on rows or columns",
+						"enum":        []string{"ROWS", "COLUMNS"},
+					},
+					"start": map[string]interface{}{
+						"type":        "string",
+						"description": "First row number (1-based, e.g., '3') or column letter (e.g., 'C')",
+					},
+					"end": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional last row number or column letter, inclusive. Defaults to start.",
+					},
+					"destination": map[string]interface{}{
+						"type":        "string",
+						"description": "Row number or column letter to move before, as laid out before the move",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet", "dimension", "start", "destination"},
+			},
+		},
+		{
+			"name":        "resize_dimension",
+			"description": "Set a fixed height for rows or width for columns, in pixels.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet. Defaults to the first sheet.",
+					},
+					"dimension": map[string]interface{}{
+						"type":        "string",
+						"description": "Whether to operate on rows or columns",
+						"enum":        []string{"ROWS", "COLUMNS"},
+					},
+					"start": map[string]interface{}{
+						"type":        "string",
+						"description": "First row number (1-based, e.g., '3') or column letter (e.g., 'C')",
+					},
+					"end": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional last row number or column letter, inclusive. Defaults to start.",
+					},
+					"pixel_size": map[string]interface{}{
+						"type":        "integer",
+						"description": "Row height or column width in pixels",
+					},
+				},
+				"required": []string{"spreadsheet_id", "dimension", "start", "pixel_size"},
+			},
+		},
+		{
+			"name":        "auto_resize",
+			"description": "Automatically fit row heights or column widths to their contents.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet. Defaults to the first sheet.",
+					},
+					"dimension": map[string]interface{}{
+						"type":        "string",
+						"description": "Whether to operate on rows or columns",
+						"enum":        []string{"ROWS", "COLUMNS"},
+					},
+					"start": map[string]interface{}{
+						"type":        "string",
+						"description": "First row number (1-based, e.g., '3') or column letter (e.g., 'C')",
+					},
+					"end": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional last row number or column letter, inclusive. Defaults to start.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "dimension", "start"},
+			},
+		},
+		{
+			"name":        "freeze_panes",
+			"description": "Freeze or unfreeze rows and columns at the top and left of a sheet. Set a count to 0 to unfreeze.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet",
+					},
+					"rows": map[string]interface{}{
+						"type":        "integer",
+						"description": "Number of rows to freeze (0 to unfreeze). Omit to leave unchanged.",
+					},
+					"columns": map[string]interface{}{
+						"type":        "integer",
+						"description": "Number of columns to freeze (0 to unfreeze). Omit to leave unchanged.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet"},
+			},
+		},
+		{
+			"name":        "merge_cells",
+			"description": "Merge the cells in a range.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range to merge (e.g., 'Sheet1!A1:D1')",
+					},
+					"merge_type": map[string]interface{}{
+						"type":        "string",
+						"description": "MERGE_ALL merges into one cell, MERGE_COLUMNS merges each column, MERGE_ROWS merges each row (default: MERGE_ALL)",
+						"enum":        []string{"MERGE_ALL", "MERGE_COLUMNS", "MERGE_ROWS"},
+					},
+				},
+				"required": []string{"spreadsheet_id", "range"},
+			},
+		},
+		{
+			"name":        "unmerge_cells",
+			"description": "Unmerge all merged cells that overlap a range.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range to unmerge",
+					},
+				},
+				"required": []string{"spreadsheet_id", "range"},
+			},
+		},
+		{
+			"name":        "update_grid_properties",
+			"description": "Change a sheet's grid size or hide its gridlines.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet",
+					},
+					"row_count": map[string]interface{}{
+						"type":        "integer",
+						"description": "New number of rows in the grid",
+					},
+					"column_count": map[string]interface{}{
+						"type":        "integer",
+						"description": "New number of columns in the grid",
+					},
+					"hide_gridlines": map[string]interface{}{
+						"type":        "boolean",
+						"description": "True to hide gridlines, false to show them",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet"},
+			},
+		},
+		{
+			"name":        "find_replace",
+			"description": "Find and replace text in cell values, and optionally formulas, without rewriting the rest of the sheet. Searches all sheets unless a range or sheet is given.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"find": map[string]interface{}{
+						"type":        "string",
+						"description": "Text or regular expression to find",
+					},
+					"replacement": map[string]interface{}{
+						"type":        "string",
+						"description": "Replacement text. Regex capture groups can be referenced as $1. Omit to delete matches.",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional A1 notation range or named range to limit the search to",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional title or sheet ID to limit the search to",
+					},
+					"match_case": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Case-sensitive matching (default: false)",
+					},
+					"match_entire_cell": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Only match cells whose entire content matches (default: false)",
+					},
+					"search_by_regex": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Treat find as a regular expression (default: false)",
+					},
+					"include_formulas": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Also search and replace inside formulas (default: false)",
+					},
+				},
+				"required": []string{"spreadsheet_id", "find"},
+			},
+		},
+		{
+			"name":        "sort_range",
+			"description": "Sort the rows of a range in place, preserving formatting and formulas. Columns can be given by header name or column letter.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range to sort (e.g., 'Sheet1!A1:F200')",
+					},
+					"sort_specs": sortSpecsSchema("Sort keys in priority order"),
+					"has_header": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Whether the first row of the range is a header row that stays in place (default: true)",
+					},
+				},
+				"required": []string{"spreadsheet_id", "range", "sort_specs"},
+			},
+		},
+		{
+			"name":        "set_basic_filter",
+			"description": "Set the basic filter on a range, replacing any existing basic filter on the sheet. The first row of the range is the header row.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range to filter, including the header row",
+					},
+					"sort_specs": sortSpecsSchema("Optional sort order applied by the filter"),
+					"criteria":   filterCriteriaSchema(),
+				},
+				"required": []string{"spreadsheet_id", "range"},
+			},
+		},
+		{
+			"name":        "clear_basic_filter",
+			"description": "Remove the basic filter from a sheet, showing all rows again.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet"},
+			},
+		},
+		{
+			"name":        "create_filter_view",
+			"description": "Create a filter view: a saved filter and sort that each user can apply without changing what others see. Columns can be given by header name or column letter.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"title": map[string]interface{}{
+						"type":        "string",
+						"description": "Name of the filter view",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range to filter, including the header row",
+					},
+					"sort_specs": sortSpecsSchema("Optional sort order applied by the filter view"),
+					"criteria":   filterCriteriaSchema(),
+				},
+				"required": []string{"spreadsheet_id", "title", "range"},
+			},
+		},
+		{
+			"name":        "list_filter_views",
+			"description": "List filter views with their IDs, ranges, sort specs and criteria.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional title or sheet ID to limit results to",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "update_filter_view",
+			"description": "Update a filter view's title, range, sort specs or criteria. Omitted fields are left unchanged; pass an empty list to clear sort specs or criteria.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"filter_view_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "ID of the filter view, as returned by create_filter_view or list_filter_views",
+					},
+					"title": map[string]interface{}{
+						"type":        "string",
+						"description": "New name of the filter view",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "New A1 notation range or named range, including the header row",
+					},
+					"sort_specs": sortSpecsSchema("New sort order"),
+					"criteria":   filterCriteriaSchema(),
+				},
+				"required": []string{"spreadsheet_id", "filter_view_id"},
+			},
+		},
+		{
+			"name":        "delete_filter_view",
+			"description": "Delete a filter view.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"filter_view_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "ID of the filter view to delete",
+					},
+				},
+				"required": []string{"spreadsheet_id", "filter_view_id"},
+			},
+		},
+		{
+			"name":        "create_slicer",
+			"description": "Add a slicer: an interactive control placed over the grid that filters a data range by one column.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"data_range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range the slicer filters, including the header row",
+					},
+					"column": map[string]interface{}{
+						"type":        "string",
+						"description": "Header name or column letter to filter on",
+					},
+					"anchor_cell": map[string]interface{}{
+						"type":        "string",
+						"description": "Cell where the slicer's top-left corner is placed (e.g., 'Dashboard!A1')",
+					},
+					"title": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional slicer title",
+					},
+					"hidden_values": map[string]interface{}{
+						"type":        "array",
+						"description": "Optional values to hide initially",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"condition": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional condition rows must meet, e.g. NUMBER_GREATER, TEXT_CONTAINS",
+					},
+					"condition_values": map[string]interface{}{
+						"type":        "array",
+						"description": "Values for the condition",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"apply_to_pivot_tables": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Whether the slicer also filters pivot tables built on the data range (default: true)",
+					},
+				},
+				"required": []string{"spreadsheet_id", "data_range", "column", "anchor_cell"},
+			},
+		},
+		{
+			"name":        "list_slicers",
+			"description": "List slicers with their IDs, data ranges, columns and positions.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional title or sheet ID to limit results to",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "update_slicer",
+			"description": "Update a slicer's data range, column, title, criteria or position. Omitted fields are left unchanged.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"slicer_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "ID of the slicer, as returned by create_slicer or list_slicers",
+					},
+					"data_range": map[string]interface{}{
+						"type":        "string",
+						"description": "New data range, including the header row",
+					},
+					"column": map[string]interface{}{
+						"type":        "string",
+						"description": "New header name or column letter to filter on",
+					},
+					"anchor_cell": map[string]interface{}{
+						"type":        "string",
+						"description": "New cell for the slicer's top-left corner",
+					},
+					"title": map[string]interface{}{
+						"type":        "string",
+						"description": "New slicer title",
+					},
+					"hidden_values": map[string]interface{}{
+						"type":        "array",
+						"description": "Values to hide. Pass an empty list to show all values.",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"condition": map[string]interface{}{
+						"type":        "string",
+						"description": "Condition rows must meet, e.g. NUMBER_GREATER, TEXT_CONTAINS",
+					},
+					"condition_values": map[string]interface{}{
+						"type":        "array",
+						"description": "Values for the condition",
+						"items": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"apply_to_pivot_tables": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Whether the slicer also filters pivot tables built on the data range",
+					},
+				},
+				"required": []string{"spreadsheet_id", "slicer_id"},
+			},
+		},
+		{
+			"name":        "delete_slicer",
+			"description": "Delete a slicer.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"slicer_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "ID of the slicer to delete",
+					},
+				},
+				"required": []string{"spreadsheet_id", "slicer_id"},
+			},
+		},
+		{
+			"name":        "set_note",
+			"description": "Set a note on every cell in a range, e.g. to leave review comments next to data.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range (e.g., 'Sheet1!B2')",
+					},
+					"note": map[string]interface{}{
+						"type":        "string",
+						"description": "The note text",
+					},
+				},
+				"required": []string{"spreadsheet_id", "range", "note"},
+			},
+		},
+		{
+			"name":        "clear_notes",
+			"description": "Remove the notes from every cell in a range. Cell values are left unchanged.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range",
+					},
+				},
+				"required": []string{"spreadsheet_id", "range"},
+			},
+		},
+		{
+			"name":        "set_hyperlink",
+			"description": "Write a hyperlink into a cell, either as rich text (optionally linking only part of the text) or as a =HYPERLINK formula.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"cell": map[string]interface{}{
+						"type":        "string",
+						"description": "The cell to write (e.g., 'Sheet1!C5')",
+					},
+					"url": map[string]interface{}{
+						"type":        "string",
+						"description": "The link target",
+					},
+					"text": map[string]interface{}{
+						"type":        "string",
+						"description": "Cell text. Defaults to the URL.",
+					},
+					"link_text": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional part of the text to link. Defaults to the whole text. Not supported with use_formula.",
+					},
+					"use_formula": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Write a =HYPERLINK formula instead of rich text (default: false)",
+					},
+				},
+				"required": []string{"spreadsheet_id", "cell", "url"},
+			},
+		},
+		{
+			"name":        "read_cells",
+			"description": "Read detailed cell information for auditing: effective value, formatted value, formula, number format, text style, background color, note and data validation. Empty cells are omitted.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range to read (e.g., 'Report!A1:F20')",
+					},
+				},
+				"required": []string{"spreadsheet_id", "range"},
+			},
+		},
+		{
+			"name":        "add_banding",
+			"description": "Apply alternating row (or column) colors to a range, with optional header and footer colors.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 notation range or named range to band (e.g., 'Sheet1!A1:F50')",
+					},
+					"header_color": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional hex color for the first row (or column)",
+					},
+					"first_band_color": map[string]interface{}{
+						"type":        "string",
+						"description": "Hex color for odd bands (default: '#FFFFFF')",
+					},
+					"second_band_color": map[string]interface{}{
+						"type":        "string",
+						"description": "Hex color for even bands (default: '#F3F3F3')",
+					},
+					"footer_color": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional hex color for the last row (or column)",
+					},
+					"columns": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Band columns instead of rows (default: false)",
+					},
+				},
+				"required": []string{"spreadsheet_id", "range"},
+			},
+		},
+		{
+			"name":        "list_banded_ranges",
+			"description": "List banded ranges with their IDs, ranges and colors.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional title or sheet ID to limit results to",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "update_banding",
+			"description": "Change the range or colors of a banded range. Omitted fields are left unchanged.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"banded_range_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "ID of the banded range, as returned by add_banding or list_banded_ranges",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "New A1 notation range or named range",
+					},
+					"header_color": map[string]interface{}{
+						"type":        "string",
+						"description": "New hex header color",
+					},
+					"first_band_color": map[string]interface{}{
+						"type":        "string",
+						"description": "New hex color for odd bands",
+					},
+					"second_band_color": map[string]interface{}{
+						"type":        "string",
+						"description": "New hex color for even bands",
+					},
+					"footer_color": map[string]interface{}{
+						"type":        "string",
+						"description": "New hex footer color",
+					},
+				},
+				"required": []string{"spreadsheet_id", "banded_range_id"},
+			},
+		},
+		{
+			"name":        "delete_banding",
+			"description": "Remove alternating colors from a banded range.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"banded_range_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "ID of the banded range to delete",
+					},
+				},
+				"required": []string{"spreadsheet_id", "banded_range_id"},
+			},
+		},
+		{
+			"name":        "create_developer_metadata",
+			"description": "Tag the spreadsheet, a sheet, or rows/columns with a key/value pair (e.g. a record ID). Tags on rows and columns move with them through inserts, deletes and sorts.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"key": map[string]interface{}{
+						"type":        "string",
+						"description": "Metadata key",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional metadata value",
+					},
+					"visibility": map[string]interface{}{
+						"type":        "string",
+						"description": "DOCUMENT (visible to anyone with access) or PROJECT (only this OAuth project). Default: DOCUMENT.",
+						"enum":        []string{"DOCUMENT", "PROJECT"},
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID. Without dimension, tags the whole sheet; omit both to tag the spreadsheet.",
+					},
+					"dimension": map[string]interface{}{
+						"type":        "string",
+						"description": "Tag rows or columns instead of a whole sheet",
+						"enum":        []string{"ROWS", "COLUMNS"},
+					},
+					"start": map[string]interface{}{
+						"type":        "string",
+						"description": "First row number (1-based) or column letter to tag",
+					},
+					"end": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional last row number or column letter, inclusive. Defaults to start.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "key"},
+			},
+		},
+		{
+			"name":        "search_developer_metadata",
+			"description": "Find developer metadata by ID, key, value and/or location type, returning where each match is attached.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"metadata_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Match metadata with this ID",
+					},
+					"key": map[string]interface{}{
+						"type":        "string",
+						"description": "Match metadata with this key",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Match metadata with this value",
+					},
+					"location_type": map[string]interface{}{
+						"type":        "string",
+						"description": "Only match metadata attached to this kind of location",
+						"enum":        []string{"SPREADSHEET", "SHEET", "ROW", "COLUMN"},
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "delete_developer_metadata",
+			"description": "Delete all developer metadata matching an ID, key, value and/or location type.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"metadata_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Match metadata with this ID",
+					},
+					"key": map[string]interface{}{
+						"type":        "string",
+						"description": "Match metadata with this key",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Match metadata with this value",
+					},
+					"location_type": map[string]interface{}{
+						"type":        "string",
+						"description": "Only match metadata attached to this kind of location",
+						"enum":        []string{"SPREADSHEET", "SHEET", "ROW", "COLUMN"},
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "read_by_metadata",
+			"description": "Read the values of the rows, columns or sheets tagged with matching developer metadata.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"metadata_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Match metadata with this ID",
+					},
+					"key": map[string]interface{}{
+						"type":        "string",
+						"description": "Match metadata with this key",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Match metadata with this value",
+					},
+					"location_type": map[string]interface{}{
+						"type":        "string",
+						"description": "Only match metadata attached to this kind of location",
+						"enum":        []string{"SPREADSHEET", "SHEET", "ROW", "COLUMN"},
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "copy_sheet_to",
+			"description": "Copy a sheet (tab) with its data and formatting into another spreadsheet, optionally renaming the copy.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the source Google Spreadsheet",
+					},
+					"sheet": map[string]interface{}{
+						"type":        "string",
+						"description": "Title or sheet ID of the sheet to copy",
+					},
+					"destination_spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the spreadsheet to copy the sheet into",
+					},
+					"new_title": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional title for the copy. Defaults to 'Copy of <title>'.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "sheet", "destination_spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "list_spreadsheets",
+			"description": "List Google Spreadsheets in the user's Drive, most recently modified first. Use this to discover spreadsheet IDs.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"folder_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional Drive folder ID to list spreadsheets from",
+					},
+					"page_size": map[string]interface{}{
+						"type":        "integer",
+						"description": "Maximum number of spreadsheets to return (1-1000, default 100)",
+					},
+					"page_token": map[string]interface{}{
+						"type":        "string",
+						"description": "next_page_token from a previous call, to fetch the next page",
+					},
+				},
+				"required": []string{},
+			},
+		},
+		{
+			"name":        "search_spreadsheets",
+			"description": "Search Drive for spreadsheets by name, modification time, owner or folder. All filters are combined.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"name_contains": map[string]interface{}{
+						"type":        "string",
+						"description": "Text the file name must contain",
+					},
+					"modified_after": map[string]interface{}{
+						"type":        "string",
+						"description": "Only files modified after this time (RFC 3339 timestamp or YYYY-MM-DD)",
+					},
+					"owner": map[string]interface{}{
+						"type":        "string",
+						"description": "Email address of an owner of the file",
+					},
+					"folder_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Drive folder ID the file must be in",
+					},
+					"mime_type": map[string]interface{}{
+						"type":        "string",
+						"description": "MIME type to match. Defaults to Google Sheets (application/vnd.google-apps.spreadsheet).",
+					},
+					"page_size": map[string]interface{}{
+						"type":        "integer",
+						"description": "Maximum number of files to return (1-1000, default 100)",
+					},
+					"page_token": map[string]interface{}{
+						"type":        "string",
+						"description": "next_page_token from a previous call, to fetch the next page",
+					},
+				},
+				"required": []string{},
+			},
+		},
+		{
+			"name":        "copy_spreadsheet",
+			"description": "Copy an entire spreadsheet (e.g. a template) to a new file. Returns the new spreadsheet ID and URL.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the spreadsheet to copy",
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional name for the copy. Defaults to 'Copy of <name>'.",
+					},
+					"folder_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional Drive folder ID to create the copy in. Defaults to the original's folder.",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "rename_spreadsheet",
+			"description": "Rename a spreadsheet file in Google Drive",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "New name for the spreadsheet",
+					},
+				},
+				"required": []string{"spreadsheet_id", "name"},
+			},
+		},
+		{
+			"name":        "move_spreadsheet",
+			"description": "Move a spreadsheet into a Drive folder, removing it from its current folders",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"folder_id": map[string]interface{}{
+						"type":        "string",
+						"description": "ID of the destination Drive folder",
+					},
+				},
+				"required": []string{"spreadsheet_id", "folder_id"},
+			},
+		},
+		{
+			"name":        "trash_spreadsheet",
+			"description": "Move a spreadsheet to the Google Drive trash. It can be restored with restore_spreadsheet.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "restore_spreadsheet",
+			"description": "Restore a spreadsheet from the Google Drive trash",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "share_spreadsheet",
+			"description": "Share a spreadsheet with a user, group, domain or anyone with the link. Only domains allowed by the server's share allowlist can be granted access.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"type": map[string]interface{}{
+						"type":        "string",
+						"description": "Who to share with",
+						"enum":        []string{"user", "group", "domain", "anyone"},
+					},
+					"role": map[string]interface{}{
+						"type":        "string",
+						"description": "Access level to grant",
+						"enum":        []string{"reader", "commenter", "writer"},
+					},
+					"email_address": map[string]interface{}{
+						"type":        "string",
+						"description": "Email address of the user or group (required for user and group)",
+					},
+					"domain": map[string]interface{}{
+						"type":        "string",
+						"description": "Domain to share with (required for domain)",
+					},
+					"send_notification": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Email users and groups about the share (default: true)",
+					},
+					"message": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional message to include in the notification email",
+					},
+				},
+				"required": []string{"spreadsheet_id", "type", "role"},
+			},
+		},
+		{
+			"name":        "list_permissions",
+			"description": "List who has access to a spreadsheet and with which role",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "update_permission",
+			"description": "Change the role of an existing permission on a spreadsheet",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"permission": map[string]interface{}{
+						"type":        "string",
+						"description": "Permission ID, email address or domain of the permission to change",
+					},
+					"role": map[string]interface{}{
+						"type":        "string",
+						"description": "New access level",
+						"enum":        []string{"reader", "commenter", "writer"},
+					},
+				},
+				"required": []string{"spreadsheet_id", "permission", "role"},
+			},
+		},
+		{
+			"name":        "revoke_permission",
+			"description": "Remove a user's, group's or domain's access to a spreadsheet",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"permission": map[string]interface{}{
+						"type":        "string",
+						"description": "Permission ID, email address or domain of the permission to remove",
+					},
+				},
+				"required": []string{"spreadsheet_id", "permission"},
+			},
+		},
+		{
+			"name":        "list_revisions",
+			"description": "List the stored revisions of a spreadsheet with author and timestamp, oldest first",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "export_revision",
+			"description": "Export a past revision of a spreadsheet to a local CSV or XLSX file in the server's export directory. CSV exports also return the sheet's values for comparison with read_sheet.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"revision_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Revision ID from list_revisions",
+					},
+					"format": map[string]interface{}{
+						"type":        "string",
+						"description": "Export format (default: csv)",
+						"enum":        []string{"csv", "xlsx"},
+					},
+					"sheet_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Sheet ID (gid) to export as CSV. Defaults to the first sheet.",
+					},
+					"output_path": map[string]interface{}{
+						"type":        "string",
+						"description": "File path inside an allowed export directory. Relative paths are resolved against the default export directory.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "revision_id"},
+			},
+		},
+		{
+			"name":        "list_comments",
+			"description": "List comments on a spreadsheet with their replies, authors and anchor information. Resolved comments are skipped by default.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"include_resolved": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Also return resolved comments (default: false)",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "create_comment",
+			"description": "Add a comment to a spreadsheet. Comments created this way apply to the whole file, not a specific cell.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"content": map[string]interface{}{
+						"type":        "string",
+						"description": "Text of the comment",
+					},
+				},
+				"required": []string{"spreadsheet_id", "content"},
+			},
+		},
+		{
+			"name":        "reply_to_comment",
+			"description": "Reply to a comment on a spreadsheet",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"comment_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Comment ID from list_comments",
+					},
+					"content": map[string]interface{}{
+						"type":        "string",
+						"description": "Text of the reply",
+					},
+				},
+				"required": []string{"spreadsheet_id", "comment_id", "content"},
+			},
+		},
+		{
+			"name":        "resolve_comment",
+			"description": "Mark a comment on a spreadsheet as resolved, optionally with a closing reply",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"comment_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Comment ID from list_comments",
+					},
+					"content": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional reply explaining the resolution",
+					},
+				},
+				"required": []string{"spreadsheet_id", "comment_id"},
+			},
+		},
+		{
+			"name":        "export_pdf",
+			"description": "Export a spreadsheet, a single sheet or a range as a PDF file in the server's export directory, with page layout options",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"sheet_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Sheet ID (gid) to export. Defaults to all sheets.",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "A1 range on the chosen sheet, without the sheet name (e.g., 'A1:F40'). Requires sheet_id.",
+					},
+					"orientation": map[string]interface{}{
+						"type":        "string",
+						"description": "Page orientation (default: portrait)",
+						"enum":        []string{"portrait", "landscape"},
+					},
+					"fit_to_width": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Scale content to fit the page width (default: false)",
+					},
+					"gridlines": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Print gridlines",
+					},
+					"paper_size": map[string]interface{}{
+						"type":        "string",
+						"description": "Paper size (default: letter)",
+						"enum":        []string{"letter", "legal", "tabloid", "statement", "executive", "folio", "A3", "A4", "A5", "B4", "B5"},
+					},
+					"output_path": map[string]interface{}{
+						"type":        "string",
+						"description": "File path inside an allowed export directory. Relative paths are resolved against the default export directory.",
+					},
+				},
+				"required": []string{"spreadsheet_id"},
+			},
+		},
+		{
+			"name":        "create_from_template",
+			"description": "Create a spreadsheet by copying a template and replacing {{placeholders}} in all cells and formulas. Repeated sections (e.g. invoice lines) can be filled from a list of records.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"template_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the template spreadsheet",
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "Name for the new spreadsheet",
+					},
+					"folder_id": map[string]interface{}{
+						"type":        "string",
+						"description": "Optional Drive folder ID to create the spreadsheet in. Defaults to the template's folder.",
+					},
+					"values": map[string]interface{}{
+						"type":        "object",
+						"description": "Placeholder values, e.g. {\"customer\": \"Acme\"} replaces {{customer}} on every sheet",
+						"additionalProperties": map[string]interface{}{
+							"type": "string",
+						},
+					},
+					"sections": map[string]interface{}{
+						"type":        "array",
+						"description": "Blocks of template rows to repeat once per record. Each copy's {{field}} placeholders are replaced from its record; an empty records list removes the block.",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"range": map[string]interface{}{
+									"type":        "string",
+									"description": "A1 range of the template rows to repeat (e.g., 'Invoice!A12:F12')",
+								},
+								"records": map[string]interface{}{
+									"type":        "array",
+									"description": "One object of field values per copy",
+									"items": map[string]interface{}{
+										"type": "object",
+										"additionalProperties": map[string]interface{}{
+											"type": "string",
+										},
+									},
+								},
+							},
+							"required": []string{"range", "records"},
+						},
+					},
+				},
+				"required": []string{"template_id", "name"},
+			},
+		},
+		{
+			"name":        "diff_range",
+			"description": "Compare proposed values with the current contents of a range without writing anything. Returns the cells that would be added, removed or changed, and optionally a row-level diff keyed by a column.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"spreadsheet_id": map[string]interface{}{
+						"type":        "string",
+						"description": "The ID of the Google Spreadsheet",
+					},
+					"range": map[string]interface{}{
+						"type":        "string",
+						"description": "The A1 notation range or named range to compare (e.g., 'Sheet1!A1:D10'). A single cell marks the top-left corner of the proposed values.",
+					},
+					"values": map[string]interface{}{
+						"type":        "array",
+						"description": "2D array of proposed values, as they would be passed to write_sheet. Cells in the range not covered by the proposal count as cleared.",
+						"items": map[string]interface{}{
+							"type": "array",
+							"items": map[string]interface{}{
+								"type": "string",
+							},
+						},
+					},
+					"key_column": map[string]interface{}{
+						"type":        "string",
+						"description": "Header name or column letter identifying rows. When set, rows below the header row are also matched by this column and reported as added, removed or changed.",
+					},
+				},
+				"required": []string{"spreadsheet_id", "range", "values"},
+			},
+		},
+	}
+
+	for _, tool := range tools {
+		if !mutatingTools[tool["name"].(string)] {
+			continue
+		}
+		schema := tool["inputSchema"].(map[string]interface{})
+		schema["properties"].(map[string]interface{})["dry_run"] = map[string]interface{}{
+			"type":        "boolean",
+			"description": "Validate the request and report the changes it would make without applying them (default: false)",
+		}
+	}
+
+	return MCPResponse{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result: map[string]interface{}{
+			"tools": tools,
+		},
+	}
+}
+
+func (s *MCPServer) handleToolsCall(req MCPRequest) MCPResponse {
+	var params struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return MCPResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &MCPError{
+				Code:    -32602,
+				Message: "Invalid params",
+				Data:    err.Error(),
+			},
+		}
+	}
+
+	// A dry run gives the tool a context that stops it before any mutating call
+	server := s
+	if mutatingTools[params.Name] {
+		var options struct {
+			DryRun bool `json:"dry_run"`
+		}
+		if err := json.Unmarshal(params.Arguments, &options); err == nil && options.DryRun {
+			dry := *s
+			dry.ctx = dryrun.With(s.ctx)
+			server = &dry
+		}
+	}
+
+	var result interface{}
+	var err error
+
+	switch params.Name {
+	case "read_sheet":
+		result, err = server.handleReadSheet(params.Arguments)
+	case "write_sheet":
+		result, err = server.handleWriteSheet(params.Arguments)
+	case "append_sheet":
+		result, err = server.handleAppendSheet(params.Arguments)
+	case "create_spreadsheet":
+		result, err = server.handleCreateSpreadsheet(params.Arguments)
+	case "get_spreadsheet_info":
+		result, err = server.handleGetSpreadsheetInfo(params.Arguments)
+	case "add_sheet":
+		result, err = server.handleAddSheet(params.Arguments)
+	case "clear_sheet":
+		result, err = server.handleClearSheet(params.Arguments)
+	case "batch_update":
+		result, err = server.handleBatchUpdate(params.Arguments)
+	case "create_chart":
+		result, err = server.handleCreateChart(params.Arguments)
+	case "update_chart":
+		result, err = server.handleUpdateChart(params.Arguments)
+	case "delete_chart":
+		result, err = server.handleDeleteChart(params.Arguments)
+	case "create_pivot_table":
+		result, err = server.handleCreatePivotTable(params.Arguments)
+	case "list_named_ranges":
+		result, err = server.handleListNamedRanges(params.Arguments)
+	case "create_named_range":
+		result, err = server.handleCreateNamedRange(params.Arguments)
+	case "update_named_range":
+		result, err = server.handleUpdateNamedRange(params.Arguments)
+	case "delete_named_range":
+		result, err = server.handleDeleteNamedRange(params.Arguments)
+	case "add_protected_range":
+		result, err = server.handleAddProtectedRange(params.Arguments)
+	case "list_protected_ranges":
+		result, err = server.handleListProtectedRanges(params.Arguments)
+	case "update_protected_range":
+		result, err = server.handleUpdateProtectedRange(params.Arguments)
+	case "delete_protected_range":
+		result, err = server.handleDeleteProtectedRange(params.Arguments)
+	case "delete_sheet":
+		result, err = server.handleDeleteSheet(params.Arguments)
+	case "rename_sheet":
+		result, err = server.handleRenameSheet(params.Arguments)
+	case "duplicate_sheet":
+		result, err = server.handleDuplicateSheet(params.Arguments)
+	case "move_sheet":
+		result, err = server.handleMoveSheet(params.Arguments)
+	case "set_sheet_hidden":
+		result, err = server.handleSetSheetHidden(params.Arguments)
+	case "set_tab_color":
+		result, err = server.handleSetTabColor(params.Arguments)
+	case "insert_dimension":
+		result, err = server.handleInsertDimension(params.Arguments)
+	case "delete_dimension":
+		result, err = server.handleDeleteDimension(params.Arguments)
+	case "move_dimension":
+		result, err = server.handleMoveDimension(params.Arguments)
+	case "resize_dimension":
+		result, err = server.handleResizeDimension(params.Arguments)
+	case "auto_resize":
+		result, err = server.handleAutoResize(params.Arguments)
+	case "freeze_panes":
+		result, err = server.handleFreezePanes(params.Arguments)
+	case "merge_cells":
+		result, err = server.handleMergeCells(params.Arguments)
+	case "unmerge_cells":
+		result, err = server.handleUnmergeCells(params.Arguments)
+	case "update_grid_properties":
+		result, err = server.handleUpdateGridProperties(params.Arguments)
+	case "find_replace":
+		result, err = server.handleFindReplace(params.Arguments)
+	case "sort_range":
+		result, err = server.handleSortRange(params.Arguments)
+	case "set_basic_filter":
+		result, err = server.handleSetBasicFilter(params.Arguments)
+	case "clear_basic_filter":
+		result, err = server.handleClearBasicFilter(params.Arguments)
+	case "create_filter_view":
+		result, err = server.handleCreateFilterView(params.Arguments)
+	case "list_filter_views":
+		result, err = server.handleListFilterViews(params.Arguments)
+	case "update_filter_view":
+		result, err = server.handleUpdateFilterView(params.Arguments)
+	case "delete_filter_view":
+		result, err = server.handleDeleteFilterView(params.Arguments)
+	case "create_slicer":
+		result, err = server.handleCreateSlicer(params.Arguments)
+	case "list_slicers":
+		result, err = server.handleListSlicers(params.Arguments)
+	case "update_slicer":
+		result, err = server.handleUpdateSlicer(params.Arguments)
+	case "delete_slicer":
+		result, err = server.handleDeleteSlicer(params.Arguments)
+	case "set_note":
+		result, err = server.handleSetNote(params.Arguments)
+	case "clear_notes":
+		result, err = server.handleClearNotes(params.Arguments)
+	case "set_hyperlink":
+		result, err = server.handleSetHyperlink(params.Arguments)
+	case "read_cells":
+		result, err = server.handleReadCells(params.Arguments)
+	case "add_banding":
+		result, err = server.handleAddBanding(params.Arguments)
+	case "list_banded_ranges":
+		result, err = server.handleListBandedRanges(params.Arguments)
+	case "update_banding":
+		result, err = server.handleUpdateBanding(params.Arguments)
+	case "delete_banding":
+		result, err = server.handleDeleteBanding(params.Arguments)
+	case "create_developer_metadata":
+		result, err = server.handleCreateDeveloperMetadata(params.Arguments)
+	case "search_developer_metadata":
+		result, err = server.handleSearchDeveloperMetadata(params.Arguments)
+	case "delete_developer_metadata":
+		result, err = server.handleDeleteDeveloperMetadata(params.Arguments)
+	case "read_by_metadata":
+		result, err = server.handleReadByMetadata(params.Arguments)
+	case "copy_sheet_to":
+		result, err = server.handleCopySheetTo(params.Arguments)
+	case "list_spreadsheets":
+		result, err = server.handleListSpreadsheets(params.Arguments)
+	case "search_spreadsheets":
+		result, err = server.handleSearchSpreadsheets(params.Arguments)
+	case "copy_spreadsheet":
+		result, err = server.handleCopySpreadsheet(params.Arguments)
+	case "rename_spreadsheet":
+		result, err = server.handleRenameSpreadsheet(params.Arguments)
+	case "move_spreadsheet":
+		result, err = server.handleMoveSpreadsheet(params.Arguments)
+	case "trash_spreadsheet":
+		result, err = server.handleTrashSpreadsheet(params.Arguments)
+	case "restore_spreadsheet":
+		result, err = server.handleRestoreSpreadsheet(params.Arguments)
+	case "share_spreadsheet":
+		result, err = server.handleShareSpreadsheet(params.Arguments)
+	case "list_permissions":
+		result, err = server.handleListPermissions(params.Arguments)
+	case "update_permission":
+		result, err = server.handleUpdatePermission(params.Arguments)
+	case "revoke_permission":
+		result, err = server.handleRevokePermission(params.Arguments)
+	case "list_revisions":
+		result, err = server.handleListRevisions(params.Arguments)
+	case "export_revision":
+		result, err = server.handleExportRevision(params.Arguments)
+	case "list_comments":
+		result, err = server.handleListComments(params.Arguments)
+	case "create_comment":
+		result, err = server.handleCreateComment(params.Arguments)
+	case "reply_to_comment":
+		result, err = server.handleReplyToComment(params.Arguments)
+	case "resolve_comment":
+		result, err = server.handleResolveComment(params.Arguments)
+	case "export_pdf":
+		result, err = server.handleExportPDF(params.Arguments)
+	case "create_from_template":
+		result, err = server.handleCreateFromTemplate(params.Arguments)
+	case "diff_range":
+		result, err = server.handleDiffRange(params.Arguments)
+	default:
+		return MCPResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &MCPError{
+				Code:    -32601,
+				Message: fmt.Sprintf("Tool not found: %s", params.Name),
+			},
+		}
+	}
+
+	if err != nil {
+		return MCPResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &MCPError{
+				Code:    -32000,
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return MCPResponse{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result: map[string]interface{}{
+			"content": []map[string]interface{}{
+				{
+					"type": "text",
+					"text": fmt.Sprintf("%v", result),
+				},
+			},
+		},
+	}
+}
+
+func (s *MCPServer) handleReadSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Range         string `json:"range,omitempty"`
+		IncludeNotes  bool   `json:"include_notes,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	if params.IncludeNotes {
+		return s.sheetsClient.ReadSheetWithNotes(s.ctx, params.SpreadsheetID, params.Range)
+	}
+	return s.sheetsClient.ReadSheet(s.ctx, params.SpreadsheetID, params.Range)
+}
+
+func (s *MCPServer) handleWriteSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string     `json:"spreadsheet_id"`
+		Range         string     `json:"range"`
+		Values        [][]string `json:"values"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.WriteSheet(s.ctx, params.SpreadsheetID, params.Range, params.Values)
+}
+
+func (s *MCPServer) handleAppendSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string     `json:"spreadsheet_id"`
+		Range         string     `json:"range"`
+		Values        [][]string `json:"values"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.AppendSheet(s.ctx, params.SpreadsheetID, params.Range, params.Values)
+}
+
+func (s *MCPServer) handleCreateSpreadsheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		Title    string             `json:"title"`
+		Locale   string             `json:"locale,omitempty"`
+		TimeZone string             `json:"time_zone,omitempty"`
+		Sheets   []sheets.SheetSpec `json:"sheets,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CreateSpreadsheetWithOptions(s.ctx, params.Title, sheets.SpreadsheetOptions{
+		Locale:   params.Locale,
+		TimeZone: params.TimeZone,
+		Sheets:   params.Sheets,
+	})
+}
+
+func (s *MCPServer) handleGetSpreadsheetInfo(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.GetSpreadsheetInfo(s.ctx, params.SpreadsheetID)
+}
+
+func (s *MCPServer) handleAddSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		SheetName     string `json:"sheet_name"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.AddSheet(s.ctx, params.SpreadsheetID, params.SheetName)
+}
+
+func (s *MCPServer) handleClearSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Range         string `json:"range"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ClearSheet(s.ctx, params.SpreadsheetID, params.Range)
+}
+
+func (s *MCPServer) handleBatchUpdate(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string                   `json:"spreadsheet_id"`
+		Requests      []map[string]interface{} `json:"requests"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.BatchUpdate(s.ctx, params.SpreadsheetID, params.Requests)
+}
+
+func (s *MCPServer) handleCreateChart(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string   `json:"spreadsheet_id"`
+		ChartType     string   `json:"chart_type"`
+		DomainRange   string   `json:"domain_range"`
+		SeriesRanges  []string `json:"series_ranges"`
+		Title         string   `json:"title,omitempty"`
+		AnchorCell    string   `json:"anchor_cell,omitempty"`
+		NewSheet      bool     `json:"new_sheet,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CreateChart(s.ctx, params.SpreadsheetID, sheets.ChartOptions{
+		ChartType:    params.ChartType,
+		Title:        params.Title,
+		DomainRange:  params.DomainRange,
+		SeriesRanges: params.SeriesRanges,
+		AnchorCell:   params.AnchorCell,
+		NewSheet:     params.NewSheet,
+	})
+}
+
+func (s *MCPServer) handleUpdateChart(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string   `json:"spreadsheet_id"`
+		ChartID       int64    `json:"chart_id"`
+		ChartType     string   `json:"chart_type,omitempty"`
+		DomainRange   string   `json:"domain_range,omitempty"`
+		SeriesRanges  []string `json:"series_ranges,omitempty"`
+		Title         string   `json:"title,omitempty"`
+		AnchorCell    string   `json:"anchor_cell,omitempty"`
+		NewSheet      bool     `json:"new_sheet,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.UpdateChart(s.ctx, params.SpreadsheetID, params.ChartID, sheets.ChartOptions{
+		ChartType:    params.ChartType,
+		Title:        params.Title,
+		DomainRange:  params.DomainRange,
+		SeriesRanges: params.SeriesRanges,
+		AnchorCell:   params.AnchorCell,
+		NewSheet:     params.NewSheet,
+	})
+}
+
+func (s *MCPServer) handleDeleteChart(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		ChartID       int64  `json:"chart_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteChart(s.ctx, params.SpreadsheetID, params.ChartID)
+}
+
+func (s *MCPServer) handleCreatePivotTable(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string               `json:"spreadsheet_id"`
+		SourceRange   string               `json:"source_range"`
+		TargetCell    string               `json:"target_cell"`
+		Rows          []string             `json:"rows,omitempty"`
+		Columns       []string             `json:"columns,omitempty"`
+		Values        []sheets.PivotValue  `json:"values"`
+		Filters       []sheets.PivotFilter `json:"filters,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CreatePivotTable(s.ctx, params.SpreadsheetID, sheets.PivotTableOptions{
+		SourceRange: params.SourceRange,
+		TargetCell:  params.TargetCell,
+		Rows:        params.Rows,
+		Columns:     params.Columns,
+		Values:      params.Values,
+		Filters:     params.Filters,
+	})
+}
+
+func (s *MCPServer) handleListNamedRanges(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ListNamedRanges(s.ctx, params.SpreadsheetID)
+}
+
+func (s *MCPServer) handleCreateNamedRange(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Name          string `json:"name"`
+		Range         string `json:"range"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CreateNamedRange(s.ctx, params.SpreadsheetID, params.Name, params.Range)
+}
+
+func (s *MCPServer) handleUpdateNamedRange(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		NamedRange    string `json:"named_range"`
+		NewName       string `json:"new_name,omitempty"`
+		Range         string `json:"range,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.UpdateNamedRange(s.ctx, params.SpreadsheetID, params.NamedRange, params.NewName, params.Range)
+}
+
+func (s *MCPServer) handleDeleteNamedRange(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		NamedRange    string `json:"named_range"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteNamedRange(s.ctx, params.SpreadsheetID, params.NamedRange)
+}
+
+// protectionParams holds the arguments shared by add_protected_range and update_protected_range
+type protectionParams struct {
+	SpreadsheetID      string   `json:"spreadsheet_id"`
+	ProtectedRangeID   int64    `json:"protected_range_id,omitempty"`
+	Range              string   `json:"range,omitempty"`
+	Sheet              string   `json:"sheet,omitempty"`
+	UnprotectedRanges  []string `json:"unprotected_ranges,omitempty"`
+	Description        string   `json:"description,omitempty"`
+	WarningOnly        *bool    `json:"warning_only,omitempty"`
+	Editors            []string `json:"editors,omitempty"`
+	Groups             []string `json:"groups,omitempty"`
+	DomainUsersCanEdit *bool    `json:"domain_users_can_edit,omitempty"`
+}
+
+func (p protectionParams) options() sheets.ProtectionOptions {
+	return sheets.ProtectionOptions{
+		Range:              p.Range,
+		Sheet:              p.Sheet,
+		UnprotectedRanges:  p.UnprotectedRanges,
+		Description:        p.Description,
+		WarningOnly:        p.WarningOnly,
+		Editors:            p.Editors,
+		Groups:             p.Groups,
+		DomainUsersCanEdit: p.DomainUsersCanEdit,
+	}
+}
+
+func (s *MCPServer) handleAddProtectedRange(args json.RawMessage) (interface{}, error) {
+	var params protectionParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.AddProtectedRange(s.ctx, params.SpreadsheetID, params.options())
+}
+
+func (s *MCPServer) handleListProtectedRanges(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ListProtectedRanges(s.ctx, params.SpreadsheetID, params.Sheet)
+}
+
+func (s *MCPServer) handleUpdateProtectedRange(args json.RawMessage) (interface{}, error) {
+	var params protectionParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.UpdateProtectedRange(s.ctx, params.SpreadsheetID, params.ProtectedRangeID, params.options())
+}
+
+func (s *MCPServer) handleDeleteProtectedRange(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID    string `json:"spreadsheet_id"`
+		ProtectedRangeID int64  `json:"protected_range_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteProtectedRange(s.ctx, params.SpreadsheetID, params.ProtectedRangeID)
+}
+
+func (s *MCPServer) handleDeleteSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteSheet(s.ctx, params.SpreadsheetID, params.Sheet)
+}
+
+func (s *MCPServer) handleRenameSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+		NewTitle      string `json:"new_title"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.RenameSheet(s.ctx, params.SpreadsheetID, params.Sheet, params.NewTitle)
+}
+
+func (s *MCPServer) handleDuplicateSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+		NewTitle      string `json:"new_title,omitempty"`
+		Index         *int64 `json:"index,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	index := int64(-1)
+	if params.Index != nil {
+		index = *params.Index
+	}
+	return s.sheetsClient.DuplicateSheet(s.ctx, params.SpreadsheetID, params.Sheet, params.NewTitle, index)
+}
+
+func (s *MCPServer) handleMoveSheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+		Index         int64  `json:"index"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.MoveSheet(s.ctx, params.SpreadsheetID, params.Sheet, params.Index)
+}
+
+func (s *MCPServer) handleSetSheetHidden(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+		Hidden        bool   `json:"hidden"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.SetSheetHidden(s.ctx, params.SpreadsheetID, params.Sheet, params.Hidden)
+}
+
+func (s *MCPServer) handleSetTabColor(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+		Color         string `json:"color,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.SetTabColor(s.ctx, params.SpreadsheetID, params.Sheet, params.Color)
+}
+
+// dimensionParams holds the arguments shared by the row and column tools
+type dimensionParams struct {
+	SpreadsheetID string `json:"spreadsheet_id"`
+	Sheet         string `json:"sheet,omitempty"`
+	Dimension     string `json:"dimension"`
+	Start         string `json:"start"`
+	End           string `json:"end,omitempty"`
+}
+
+func (p dimensionParams) span() sheets.DimensionSpan {
+	return sheets.DimensionSpan{
+		Sheet:     p.Sheet,
+		Dimension: p.Dimension,
+		Start:     p.Start,
+		End:       p.End,
+	}
+}
+
+func (s *MCPServer) handleInsertDimension(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		dimensionParams
+		InheritFromBefore bool `json:"inherit_from_before,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.InsertDimension(s.ctx, params.SpreadsheetID, params.span(), params.InheritFromBefore)
+}
+
+func (s *MCPServer) handleDeleteDimension(args json.RawMessage) (interface{}, error) {
+	var params dimensionParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteDimension(s.ctx, params.SpreadsheetID, params.span())
+}
+
+func (s *MCPServer) handleMoveDimension(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		dimensionParams
+		Destination string `json:"destination"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.MoveDimension(s.ctx, params.SpreadsheetID, params.span(), params.Destination)
+}
+
+func (s *MCPServer) handleResizeDimension(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		dimensionParams
+		PixelSize int64 `json:"pixel_size"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ResizeDimension(s.ctx, params.SpreadsheetID, params.span(), params.PixelSize)
+}
+
+func (s *MCPServer) handleAutoResize(args json.RawMessage) (interface{}, error) {
+	var params dimensionParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.AutoResizeDimension(s.ctx, params.SpreadsheetID, params.span())
+}
+
+func (s *MCPServer) handleFreezePanes(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+		Rows          *int64 `json:"rows,omitempty"`
+		Columns       *int64 `json:"columns,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.FreezePanes(s.ctx, params.SpreadsheetID, params.Sheet, params.Rows, params.Columns)
+}
+
+func (s *MCPServer) handleMergeCells(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Range         string `json:"range"`
+		MergeType     string `json:"merge_type,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.MergeCells(s.ctx, params.SpreadsheetID, params.Range, params.MergeType)
+}
+
+func (s *MCPServer) handleUnmergeCells(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Range         string `json:"range"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.UnmergeCells(s.ctx, params.SpreadsheetID, params.Range)
+}
+
+func (s *MCPServer) handleUpdateGridProperties(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+		RowCount      *int64 `json:"row_count,omitempty"`
+		ColumnCount   *int64 `json:"column_count,omitempty"`
+		HideGridlines *bool  `json:"hide_gridlines,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.UpdateGridProperties(s.ctx, params.SpreadsheetID, params.Sheet, sheets.GridOptions{
+		RowCount:      params.RowCount,
+		ColumnCount:   params.ColumnCount,
+		HideGridlines: params.HideGridlines,
+	})
+}
+
+func (s *MCPServer) handleFindReplace(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID   string `json:"spreadsheet_id"`
+		Find            string `json:"find"`
+		Replacement     string `json:"replacement,omitempty"`
+		Range           string `json:"range,omitempty"`
+		Sheet           string `json:"sheet,omitempty"`
+		MatchCase       bool   `json:"match_case,omitempty"`
+		MatchEntireCell bool   `json:"match_entire_cell,omitempty"`
+		SearchByRegex   bool   `json:"search_by_regex,omitempty"`
+		IncludeFormulas bool   `json:"include_formulas,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.FindReplace(s.ctx, params.SpreadsheetID, sheets.FindReplaceOptions{
+		Find:            params.Find,
+		Replacement:     params.Replacement,
+		Range:           params.Range,
+		Sheet:           params.Sheet,
+		MatchCase:       params.MatchCase,
+		MatchEntireCell: params.MatchEntireCell,
+		SearchByRegex:   params.SearchByRegex,
+		IncludeFormulas: params.IncludeFormulas,
+	})
+}
+
+// sortSpecsSchema describes a list of sort keys, shared by the sort and filter tools
+func sortSpecsSchema(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "array",
+		"description": description,
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"column": map[string]interface{}{
+					"type":        "string",
+					"description": "Header name or column letter",
+				},
+				"order": map[string]interface{}{
+					"type":        "string",
+					"description": "Sort order (default: ASCENDING)",
+					"enum":        []string{"ASCENDING", "DESCENDING"},
+				},
+			},
+			"required": []string{"column"},
+		},
+	}
+}
+
+// filterCriteriaSchema describes per-column filter criteria, shared by the filter tools
+func filterCriteriaSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "array",
+		"description": "Optional per-column criteria that hide non-matching rows",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"column": map[string]interface{}{
+					"type":        "string",
+					"description": "Header name or column letter",
+				},
+				"hidden_values": map[string]interface{}{
+					"type":        "array",
+					"description": "Hide rows whose value is one of these",
+					"items": map[string]interface{}{
+						"type": "string",
+					},
+				},
+				"condition": map[string]interface{}{
+					"type":        "string",
+					"description": "Only show rows meeting this condition, e.g. NUMBER_GREATER, TEXT_CONTAINS, DATE_AFTER",
+				},
+				"condition_values": map[string]interface{}{
+					"type":        "array",
+					"description": "Values for the condition",
+					"items": map[string]interface{}{
+						"type": "string",
+					},
+				},
+			},
+			"required": []string{"column"},
+		},
+	}
+}
+
+func (s *MCPServer) handleSortRange(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string            `json:"spreadsheet_id"`
+		Range         string            `json:"range"`
+		SortSpecs     []sheets.SortSpec `json:"sort_specs"`
+		HasHeader     *bool             `json:"has_header,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	hasHeader := params.HasHeader == nil || *params.HasHeader
+	return s.sheetsClient.SortRange(s.ctx, params.SpreadsheetID, params.Range, params.SortSpecs, hasHeader)
+}
+
+func (s *MCPServer) handleSetBasicFilter(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string                   `json:"spreadsheet_id"`
+		Range         string                   `json:"range"`
+		SortSpecs     []sheets.SortSpec        `json:"sort_specs,omitempty"`
+		Criteria      []sheets.FilterCriterion `json:"criteria,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.SetBasicFilter(s.ctx, params.SpreadsheetID, params.Range, params.SortSpecs, params.Criteria)
+}
+
+func (s *MCPServer) handleClearBasicFilter(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ClearBasicFilter(s.ctx, params.SpreadsheetID, params.Sheet)
+}
+
+// filterViewParams holds the arguments shared by create_filter_view and update_filter_view
+type filterViewParams struct {
+	SpreadsheetID string                   `json:"spreadsheet_id"`
+	FilterViewID  int64                    `json:"filter_view_id,omitempty"`
+	Title         string                   `json:"title,omitempty"`
+	Range         string                   `json:"range,omitempty"`
+	SortSpecs     []sheets.SortSpec        `json:"sort_specs,omitempty"`
+	Criteria      []sheets.FilterCriterion `json:"criteria,omitempty"`
+}
+
+func (p filterViewParams) options() sheets.FilterViewOptions {
+	return sheets.FilterViewOptions{
+		Title:     p.Title,
+		Range:     p.Range,
+		SortSpecs: p.SortSpecs,
+		Criteria:  p.Criteria,
+	}
+}
+
+func (s *MCPServer) handleCreateFilterView(args json.RawMessage) (interface{}, error) {
+	var params filterViewParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CreateFilterView(s.ctx, params.SpreadsheetID, params.options())
+}
+
+func (s *MCPServer) handleListFilterViews(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ListFilterViews(s.ctx, params.SpreadsheetID, params.Sheet)
+}
+
+func (s *MCPServer) handleUpdateFilterView(args json.RawMessage) (interface{}, error) {
+	var params filterViewParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.UpdateFilterView(s.ctx, params.SpreadsheetID, params.FilterViewID, params.options())
+}
+
+func (s *MCPServer) handleDeleteFilterView(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		FilterViewID  int64  `json:"filter_view_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteFilterView(s.ctx, params.SpreadsheetID, params.FilterViewID)
+}
+
+// slicerParams holds the arguments shared by create_slicer and update_slicer
+type slicerParams struct {
+	SpreadsheetID      string   `json:"spreadsheet_id"`
+	SlicerID           int64    `json:"slicer_id,omitempty"`
+	DataRange          string   `json:"data_range,omitempty"`
+	Column             string   `json:"column,omitempty"`
+	AnchorCell         string   `json:"anchor_cell,omitempty"`
+	Title              string   `json:"title,omitempty"`
+	HiddenValues       []string `json:"hidden_values,omitempty"`
+	Condition          string   `json:"condition,omitempty"`
+	ConditionValues    []string `json:"condition_values,omitempty"`
+	ApplyToPivotTables *bool    `json:"apply_to_pivot_tables,omitempty"`
+}
+
+func (p slicerParams) options() sheets.SlicerOptions {
+	return sheets.SlicerOptions{
+		DataRange:          p.DataRange,
+		Column:             p.Column,
+		AnchorCell:         p.AnchorCell,
+		Title:              p.Title,
+		HiddenValues:       p.HiddenValues,
+		Condition:          p.Condition,
+		ConditionValues:    p.ConditionValues,
+		ApplyToPivotTables: p.ApplyToPivotTables,
+	}
+}
+
+func (s *MCPServer) handleCreateSlicer(args json.RawMessage) (interface{}, error) {
+	var params slicerParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CreateSlicer(s.ctx, params.SpreadsheetID, params.options())
+}
+
+func (s *MCPServer) handleListSlicers(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ListSlicers(s.ctx, params.SpreadsheetID, params.Sheet)
+}
+
+func (s *MCPServer) handleUpdateSlicer(args json.RawMessage) (interface{}, error) {
+	var params slicerParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.UpdateSlicer(s.ctx, params.SpreadsheetID, params.SlicerID, params.options())
+}
+
+func (s *MCPServer) handleDeleteSlicer(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		SlicerID      int64  `json:"slicer_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteSlicer(s.ctx, params.SpreadsheetID, params.SlicerID)
+}
 
-func (s *MCPServer) handleClearSheet(args json.RawMessage) (interface{}, error) {
+func (s *MCPServer) handleSetNote(args json.RawMessage) (interface{}, error) {
 	var params struct {
 		SpreadsheetID string `json:"spreadsheet_id"`
 		Range         string `json:"range"`
+		Note          string `json:"note"`
 	}
 	if err := json.Unmarshal(args, &params); err != nil {
 		return nil, err
 	}
-	return s.sheetsClient.ClearSheet(s.ctx, params.SpreadsheetID, params.Range)
+	return s.sheetsClient.SetNote(s.ctx, params.SpreadsheetID, params.Range, params.Note)
 }
 
-func (s *MCPServer) handleBatchUpdate(args json.RawMessage) (interface{}, error) {
+func (s *MCPServer) handleClearNotes(args json.RawMessage) (interface{}, error) {
 	var params struct {
-		SpreadsheetID string                   `json:"spreadsheet_id"`
-		Requests      []map[string]interface{} `json:"requests"`
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Range         string `json:"range"`
 	}
 	if err := json.Unmarshal(args, &params); err != nil {
 		return nil, err
 	}
-	return s.sheetsClient.BatchUpdate(s.ctx, params.SpreadsheetID, params.Requests)
+	return s.sheetsClient.SetNote(s.ctx, params.SpreadsheetID, params.Range, "")
+}
+
+func (s *MCPServer) handleSetHyperlink(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Cell          string `json:"cell"`
+		URL           string `json:"url"`
+		Text          string `json:"text,omitempty"`
+		LinkText      string `json:"link_text,omitempty"`
+		UseFormula    bool   `json:"use_formula,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.SetHyperlink(s.ctx, params.SpreadsheetID, params.Cell, params.URL, params.Text, params.LinkText, params.UseFormula)
+}
+
+func (s *MCPServer) handleReadCells(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Range         string `json:"range"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ReadCells(s.ctx, params.SpreadsheetID, params.Range)
+}
+
+// bandingParams holds the arguments shared by add_banding and update_banding
+type bandingParams struct {
+	SpreadsheetID   string `json:"spreadsheet_id"`
+	BandedRangeID   int64  `json:"banded_range_id,omitempty"`
+	Range           string `json:"range,omitempty"`
+	HeaderColor     string `json:"header_color,omitempty"`
+	FirstBandColor  string `json:"first_band_color,omitempty"`
+	SecondBandColor string `json:"second_band_color,omitempty"`
+	FooterColor     string `json:"footer_color,omitempty"`
+	Columns         bool   `json:"columns,omitempty"`
+}
+
+func (p bandingParams) options() sheets.BandingOptions {
+	return sheets.BandingOptions{
+		Range:           p.Range,
+		HeaderColor:     p.HeaderColor,
+		FirstBandColor:  p.FirstBandColor,
+		SecondBandColor: p.SecondBandColor,
+		FooterColor:     p.FooterColor,
+		Columns:         p.Columns,
+	}
+}
+
+func (s *MCPServer) handleAddBanding(args json.RawMessage) (interface{}, error) {
+	var params bandingParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.AddBanding(s.ctx, params.SpreadsheetID, params.options())
+}
+
+func (s *MCPServer) handleListBandedRanges(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Sheet         string `json:"sheet,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ListBandedRanges(s.ctx, params.SpreadsheetID, params.Sheet)
+}
+
+func (s *MCPServer) handleUpdateBanding(args json.RawMessage) (interface{}, error) {
+	var params bandingParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.UpdateBanding(s.ctx, params.SpreadsheetID, params.BandedRangeID, params.options())
+}
+
+func (s *MCPServer) handleDeleteBanding(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		BandedRangeID int64  `json:"banded_range_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteBanding(s.ctx, params.SpreadsheetID, params.BandedRangeID)
+}
+
+func (s *MCPServer) handleCreateDeveloperMetadata(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Key           string `json:"key"`
+		Value         string `json:"value,omitempty"`
+		Visibility    string `json:"visibility,omitempty"`
+		Sheet         string `json:"sheet,omitempty"`
+		Dimension     string `json:"dimension,omitempty"`
+		Start         string `json:"start,omitempty"`
+		End           string `json:"end,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CreateDeveloperMetadata(s.ctx, params.SpreadsheetID, params.Key, params.Value, params.Visibility, sheets.MetadataLocation{
+		Sheet:     params.Sheet,
+		Dimension: params.Dimension,
+		Start:     params.Start,
+		End:       params.End,
+	})
+}
+
+// metadataLookupParams holds the arguments shared by the developer metadata lookup tools
+type metadataLookupParams struct {
+	SpreadsheetID string `json:"spreadsheet_id"`
+	MetadataID    int64  `json:"metadata_id,omitempty"`
+	Key           string `json:"key,omitempty"`
+	Value         string `json:"value,omitempty"`
+	LocationType  string `json:"location_type,omitempty"`
+}
+
+func (p metadataLookupParams) lookup() sheets.MetadataLookup {
+	return sheets.MetadataLookup{
+		ID:           p.MetadataID,
+		Key:          p.Key,
+		Value:        p.Value,
+		LocationType: p.LocationType,
+	}
+}
+
+func (s *MCPServer) handleSearchDeveloperMetadata(args json.RawMessage) (interface{}, error) {
+	var params metadataLookupParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.SearchDeveloperMetadata(s.ctx, params.SpreadsheetID, params.lookup())
+}
+
+func (s *MCPServer) handleDeleteDeveloperMetadata(args json.RawMessage) (interface{}, error) {
+	var params metadataLookupParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DeleteDeveloperMetadata(s.ctx, params.SpreadsheetID, params.lookup())
+}
+
+func (s *MCPServer) handleReadByMetadata(args json.RawMessage) (interface{}, error) {
+	var params metadataLookupParams
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.ReadByMetadata(s.ctx, params.SpreadsheetID, params.lookup())
+}
+
+func (s *MCPServer) handleCopySheetTo(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID            string `json:"spreadsheet_id"`
+		Sheet                    string `json:"sheet"`
+		DestinationSpreadsheetID string `json:"destination_spreadsheet_id"`
+		NewTitle                 string `json:"new_title,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CopySheetTo(s.ctx, params.SpreadsheetID, params.Sheet, params.DestinationSpreadsheetID, params.NewTitle)
+}
+
+func (s *MCPServer) handleListSpreadsheets(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		FolderID  string `json:"folder_id,omitempty"`
+		PageSize  int64  `json:"page_size,omitempty"`
+		PageToken string `json:"page_token,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ListSpreadsheets(s.ctx, params.FolderID, params.PageSize, params.PageToken)
+}
+
+func (s *MCPServer) handleSearchSpreadsheets(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		NameContains  string `json:"name_contains,omitempty"`
+		ModifiedAfter string `json:"modified_after,omitempty"`
+		Owner         string `json:"owner,omitempty"`
+		FolderID      string `json:"folder_id,omitempty"`
+		MimeType      string `json:"mime_type,omitempty"`
+		PageSize      int64  `json:"page_size,omitempty"`
+		PageToken     string `json:"page_token,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.SearchSpreadsheets(s.ctx, drive.SearchOptions{
+		NameContains:  params.NameContains,
+		ModifiedAfter: params.ModifiedAfter,
+		Owner:         params.Owner,
+		FolderID:      params.FolderID,
+		MimeType:      params.MimeType,
+		PageSize:      params.PageSize,
+		PageToken:     params.PageToken,
+	})
+}
+
+func (s *MCPServer) handleCopySpreadsheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Name          string `json:"name,omitempty"`
+		FolderID      string `json:"folder_id,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.CopySpreadsheet(s.ctx, params.SpreadsheetID, params.Name, params.FolderID)
+}
+
+func (s *MCPServer) handleRenameSpreadsheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Name          string `json:"name"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.RenameSpreadsheet(s.ctx, params.SpreadsheetID, params.Name)
+}
+
+func (s *MCPServer) handleMoveSpreadsheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		FolderID      string `json:"folder_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.MoveSpreadsheet(s.ctx, params.SpreadsheetID, params.FolderID)
+}
+
+func (s *MCPServer) handleTrashSpreadsheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.SetTrashed(s.ctx, params.SpreadsheetID, true)
+}
+
+func (s *MCPServer) handleRestoreSpreadsheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.SetTrashed(s.ctx, params.SpreadsheetID, false)
+}
+
+func (s *MCPServer) handleShareSpreadsheet(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID    string `json:"spreadsheet_id"`
+		Type             string `json:"type"`
+		Role             string `json:"role"`
+		EmailAddress     string `json:"email_address,omitempty"`
+		Domain           string `json:"domain,omitempty"`
+		SendNotification *bool  `json:"send_notification,omitempty"`
+		Message          string `json:"message,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ShareSpreadsheet(s.ctx, params.SpreadsheetID, drive.ShareOptions{
+		Type:         params.Type,
+		Role:         params.Role,
+		EmailAddress: params.EmailAddress,
+		Domain:       params.Domain,
+		Notify:       params.SendNotification,
+		Message:      params.Message,
+	})
+}
+
+func (s *MCPServer) handleListPermissions(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ListPermissions(s.ctx, params.SpreadsheetID)
+}
+
+func (s *MCPServer) handleUpdatePermission(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Permission    string `json:"permission"`
+		Role          string `json:"role"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.UpdatePermission(s.ctx, params.SpreadsheetID, params.Permission, params.Role)
+}
+
+func (s *MCPServer) handleRevokePermission(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Permission    string `json:"permission"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.RevokePermission(s.ctx, params.SpreadsheetID, params.Permission)
+}
+
+func (s *MCPServer) handleListRevisions(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ListRevisions(s.ctx, params.SpreadsheetID)
+}
+
+func (s *MCPServer) handleExportRevision(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		RevisionID    string `json:"revision_id"`
+		Format        string `json:"format,omitempty"`
+		SheetID       *int64 `json:"sheet_id,omitempty"`
+		OutputPath    string `json:"output_path,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ExportRevision(s.ctx, params.SpreadsheetID, params.RevisionID, params.Format, params.SheetID, params.OutputPath)
+}
+
+func (s *MCPServer) handleListComments(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID   string `json:"spreadsheet_id"`
+		IncludeResolved bool   `json:"include_resolved,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ListComments(s.ctx, params.SpreadsheetID, params.IncludeResolved)
+}
+
+func (s *MCPServer) handleCreateComment(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		Content       string `json:"content"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.CreateComment(s.ctx, params.SpreadsheetID, params.Content)
+}
+
+func (s *MCPServer) handleReplyToComment(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		CommentID     string `json:"comment_id"`
+		Content       string `json:"content"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ReplyToComment(s.ctx, params.SpreadsheetID, params.CommentID, params.Content)
+}
+
+func (s *MCPServer) handleResolveComment(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		CommentID     string `json:"comment_id"`
+		Content       string `json:"content,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ResolveComment(s.ctx, params.SpreadsheetID, params.CommentID, params.Content)
+}
+
+func (s *MCPServer) handleExportPDF(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string `json:"spreadsheet_id"`
+		SheetID       *int64 `json:"sheet_id,omitempty"`
+		Range         string `json:"range,omitempty"`
+		Orientation   string `json:"orientation,omitempty"`
+		FitToWidth    bool   `json:"fit_to_width,omitempty"`
+		Gridlines     *bool  `json:"gridlines,omitempty"`
+		PaperSize     string `json:"paper_size,omitempty"`
+		OutputPath    string `json:"output_path,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.driveClient.ExportPDF(s.ctx, params.SpreadsheetID, drive.PDFOptions{
+		SheetID:     params.SheetID,
+		Range:       params.Range,
+		Orientation: params.Orientation,
+		FitToWidth:  params.FitToWidth,
+		Gridlines:   params.Gridlines,
+		PaperSize:   params.PaperSize,
+	}, params.OutputPath)
+}
+
+func (s *MCPServer) handleCreateFromTemplate(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		TemplateID string                   `json:"template_id"`
+		Name       string                   `json:"name"`
+		FolderID   string                   `json:"folder_id,omitempty"`
+		Values     map[string]string        `json:"values,omitempty"`
+		Sections   []sheets.TemplateSection `json:"sections,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.CreateFromTemplate(s.ctx, s.driveClient, params.TemplateID, params.Name, params.FolderID, params.Values, params.Sections)
+}
+
+func (s *MCPServer) handleDiffRange(args json.RawMessage) (interface{}, error) {
+	var params struct {
+		SpreadsheetID string     `json:"spreadsheet_id"`
+		Range         string     `json:"range"`
+		Values        [][]string `json:"values"`
+		KeyColumn     string     `json:"key_column,omitempty"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, err
+	}
+	return s.sheetsClient.DiffRange(s.ctx, params.SpreadsheetID, params.Range, params.Values, params.KeyColumn)
 }
 
 func main() {
 	// Parse command-line flags
 	versionFlag := flag.Bool("version", false, "Print version information and exit")
+	dryRunFlag := flag.Bool("dry-run", false, "Report the changes mutating tools would make without applying them")
 	flag.Parse()
 
 	// Handle --version flag
@@ -466,6 +3926,10 @@ func main() {
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
 	ctx := context.Background()
+	if *dryRunFlag {
+		ctx = dryrun.With(ctx)
+		log.Printf("Dry-run mode: mutating tools will report changes without applying them")
+	}
 	server, err := NewMCPServer(ctx)
 	if err != nil {
 		log.Fatalf("Failed to create MCP server: %v", err)
@@ -9,9 +9,8 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/conallob/mcp-google-sheets/drive"
 	"github.com/conallob/mcp-google-sheets/sheets"
-	"google.golang.org/api/option"
-	sheetsapi "google.golang.org/api/sheets/v4"
 )
 
 func TestMCPRequest_JSONParsing(t *testing.T) {
@@ -225,6 +224,78 @@ func TestHandleRequest_ToolsList(t *testing.T) {
 		"add_sheet",
 		"clear_sheet",
 		"batch_update",
+		"create_chart",
+		"update_chart",
+		"delete_chart",
+		"create_pivot_table",
+		"list_named_ranges",
+		"create_named_range",
+		"update_named_range",
+		"delete_named_range",
+		"add_protected_range",
+		"list_protected_ranges",
+		"update_protected_range",
+		"delete_protected_range",
+		"delete_sheet",
+		"rename_sheet",
+		"duplicate_sheet",
+		"move_sheet",
+		"set_sheet_hidden",
+		"set_tab_color",
+		"insert_dimension",
+		"delete_dimension",
+		"move_dimension",
+		"resize_dimension",
+		"auto_resize",
+		"freeze_panes",
+		"merge_cells",
+		"unmerge_cells",
+		"update_grid_properties",
+		"find_replace",
+		"sort_range",
+		"set_basic_filter",
+		"clear_basic_filter",
+		"create_filter_view",
+		"list_filter_views",
+		"update_filter_view",
+		"delete_filter_view",
+		"create_slicer",
+		"list_slicers",
+		"update_slicer",
+		"delete_slicer",
+		"set_note",
+		"clear_notes",
+		"set_hyperlink",
+		"read_cells",
+		"add_banding",
+		"list_banded_ranges",
+		"update_banding",
+		"delete_banding",
+		"create_developer_metadata",
+		"search_developer_metadata",
+		"delete_developer_metadata",
+		"read_by_metadata",
+		"copy_sheet_to",
+		"list_spreadsheets",
+		"search_spreadsheets",
+		"copy_spreadsheet",
+		"rename_spreadsheet",
+		"move_spreadsheet",
+		"trash_spreadsheet",
+		"restore_spreadsheet",
+		"share_spreadsheet",
+		"list_permissions",
+		"update_permission",
+		"revoke_permission",
+		"list_revisions",
+		"export_revision",
+		"list_comments",
+		"create_comment",
+		"reply_to_comment",
+		"resolve_comment",
+		"export_pdf",
+		"create_from_template",
+		"diff_range",
 	}
 
 	if len(tools) != len(expectedTools) {
@@ -498,6 +569,798 @@ func TestHandleBatchUpdate_InvalidJSON(t *testing.T) {
 	}
 }
 
+func TestHandleCreateChart_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCreateChart(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUpdateChart_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUpdateChart(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteChart_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteChart(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCreatePivotTable_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCreatePivotTable(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListNamedRanges_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListNamedRanges(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCreateNamedRange_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCreateNamedRange(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUpdateNamedRange_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUpdateNamedRange(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteNamedRange_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteNamedRange(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleAddProtectedRange_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleAddProtectedRange(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListProtectedRanges_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListProtectedRanges(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUpdateProtectedRange_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUpdateProtectedRange(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteProtectedRange_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteProtectedRange(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteSheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteSheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleRenameSheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleRenameSheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDuplicateSheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDuplicateSheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleMoveSheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleMoveSheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleSetSheetHidden_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleSetSheetHidden(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleSetTabColor_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleSetTabColor(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleInsertDimension_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleInsertDimension(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteDimension_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteDimension(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleMoveDimension_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleMoveDimension(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleResizeDimension_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleResizeDimension(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleAutoResize_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleAutoResize(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleFreezePanes_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleFreezePanes(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleMergeCells_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleMergeCells(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUnmergeCells_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUnmergeCells(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUpdateGridProperties_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUpdateGridProperties(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleFindReplace_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleFindReplace(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleSortRange_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleSortRange(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleSetBasicFilter_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleSetBasicFilter(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleClearBasicFilter_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleClearBasicFilter(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCreateFilterView_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCreateFilterView(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListFilterViews_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListFilterViews(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUpdateFilterView_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUpdateFilterView(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteFilterView_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteFilterView(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCreateSlicer_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCreateSlicer(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListSlicers_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListSlicers(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUpdateSlicer_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUpdateSlicer(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteSlicer_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteSlicer(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleSetNote_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleSetNote(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleClearNotes_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleClearNotes(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleSetHyperlink_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleSetHyperlink(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleReadCells_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleReadCells(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleAddBanding_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleAddBanding(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListBandedRanges_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListBandedRanges(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUpdateBanding_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUpdateBanding(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteBanding_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteBanding(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCreateDeveloperMetadata_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCreateDeveloperMetadata(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleSearchDeveloperMetadata_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleSearchDeveloperMetadata(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDeleteDeveloperMetadata_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDeleteDeveloperMetadata(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleReadByMetadata_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleReadByMetadata(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCopySheetTo_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCopySheetTo(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListSpreadsheets_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListSpreadsheets(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleSearchSpreadsheets_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleSearchSpreadsheets(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCopySpreadsheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCopySpreadsheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleRenameSpreadsheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleRenameSpreadsheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleMoveSpreadsheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleMoveSpreadsheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleTrashSpreadsheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleTrashSpreadsheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleRestoreSpreadsheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleRestoreSpreadsheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleShareSpreadsheet_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleShareSpreadsheet(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListPermissions_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListPermissions(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleUpdatePermission_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleUpdatePermission(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleRevokePermission_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleRevokePermission(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListRevisions_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListRevisions(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleExportRevision_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleExportRevision(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleListComments_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleListComments(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCreateComment_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCreateComment(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleReplyToComment_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleReplyToComment(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleResolveComment_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleResolveComment(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleExportPDF_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleExportPDF(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleCreateFromTemplate_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleCreateFromTemplate(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestHandleDiffRange_InvalidJSON(t *testing.T) {
+	server := &MCPServer{
+		ctx: context.Background(),
+	}
+
+	_, err := server.handleDiffRange(json.RawMessage(`invalid json`))
+	if err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
 func TestConstants(t *testing.T) {
 	if serverName == "" {
 		t.Error("serverName constant should not be empty")
@@ -673,6 +1536,7 @@ func TestHandleRequest_AllMethods(t *testing.T) {
 func TestHandleToolsCall_AllTools(t *testing.T) {
 	server := &MCPServer{
 		sheetsClient: &sheets.Client{},
+		driveClient:  &drive.Client{},
 		ctx:          context.Background(),
 	}
 
@@ -688,6 +1552,78 @@ func TestHandleToolsCall_AllTools(t *testing.T) {
 		{"add_sheet", map[string]interface{}{"spreadsheet_id": "test", "sheet_name": "New"}},
 		{"clear_sheet", map[string]interface{}{"spreadsheet_id": "test", "range": "A1"}},
 		{"batch_update", map[string]interface{}{"spreadsheet_id": "test", "requests": []map[string]interface{}{}}},
+		{"create_chart", map[string]interface{}{"spreadsheet_id": "test", "chart_type": "line", "domain_range": "A1:A5", "series_ranges": []string{"B1:B5"}}},
+		{"update_chart", map[string]interface{}{"spreadsheet_id": "test", "chart_id": 1, "title": "New"}},
+		{"delete_chart", map[string]interface{}{"spreadsheet_id": "test", "chart_id": 1}},
+		{"create_pivot_table", map[string]interface{}{"spreadsheet_id": "test", "source_range": "A1:D10", "target_cell": "F1", "values": []map[string]interface{}{{"column": "Revenue"}}}},
+		{"list_named_ranges", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"create_named_range", map[string]interface{}{"spreadsheet_id": "test", "name": "Totals", "range": "A1:B2"}},
+		{"update_named_range", map[string]interface{}{"spreadsheet_id": "test", "named_range": "Totals", "new_name": "Sums"}},
+		{"delete_named_range", map[string]interface{}{"spreadsheet_id": "test", "named_range": "Totals"}},
+		{"add_protected_range", map[string]interface{}{"spreadsheet_id": "test", "range": "A1:A1"}},
+		{"list_protected_ranges", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"update_protected_range", map[string]interface{}{"spreadsheet_id": "test", "protected_range_id": 1, "description": "x"}},
+		{"delete_protected_range", map[string]interface{}{"spreadsheet_id": "test", "protected_range_id": 1}},
+		{"delete_sheet", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1"}},
+		{"rename_sheet", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1", "new_title": "Data"}},
+		{"duplicate_sheet", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1"}},
+		{"move_sheet", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1", "index": 2}},
+		{"set_sheet_hidden", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1", "hidden": true}},
+		{"set_tab_color", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1", "color": "#FF0000"}},
+		{"insert_dimension", map[string]interface{}{"spreadsheet_id": "test", "dimension": "ROWS", "start": "2", "end": "4"}},
+		{"delete_dimension", map[string]interface{}{"spreadsheet_id": "test", "dimension": "COLUMNS", "start": "C"}},
+		{"move_dimension", map[string]interface{}{"spreadsheet_id": "test", "dimension": "COLUMNS", "start": "D", "destination": "A"}},
+		{"resize_dimension", map[string]interface{}{"spreadsheet_id": "test", "dimension": "COLUMNS", "start": "A", "pixel_size": 120}},
+		{"auto_resize", map[string]interface{}{"spreadsheet_id": "test", "dimension": "COLUMNS", "start": "A", "end": "F"}},
+		{"freeze_panes", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1", "rows": 1}},
+		{"merge_cells", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1:D1"}},
+		{"unmerge_cells", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1:D1"}},
+		{"update_grid_properties", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1", "hide_gridlines": true}},
+		{"find_replace", map[string]interface{}{"spreadsheet_id": "test", "find": "foo", "replacement": "bar"}},
+		{"sort_range", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1:C10", "sort_specs": []map[string]interface{}{{"column": "A"}}}},
+		{"set_basic_filter", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1:C10"}},
+		{"clear_basic_filter", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1"}},
+		{"create_filter_view", map[string]interface{}{"spreadsheet_id": "test", "title": "Mine", "range": "Sheet1!A1:C10"}},
+		{"list_filter_views", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"update_filter_view", map[string]interface{}{"spreadsheet_id": "test", "filter_view_id": 1, "title": "Renamed"}},
+		{"delete_filter_view", map[string]interface{}{"spreadsheet_id": "test", "filter_view_id": 1}},
+		{"create_slicer", map[string]interface{}{"spreadsheet_id": "test", "data_range": "Sheet1!A1:C10", "column": "A", "anchor_cell": "Sheet1!E1"}},
+		{"list_slicers", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"update_slicer", map[string]interface{}{"spreadsheet_id": "test", "slicer_id": 1, "title": "Region"}},
+		{"delete_slicer", map[string]interface{}{"spreadsheet_id": "test", "slicer_id": 1}},
+		{"set_note", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1", "note": "Check"}},
+		{"clear_notes", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1"}},
+		{"set_hyperlink", map[string]interface{}{"spreadsheet_id": "test", "cell": "Sheet1!A1", "url": "https://example.com"}},
+		{"read_cells", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1:B2"}},
+		{"add_banding", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1:D20"}},
+		{"list_banded_ranges", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"update_banding", map[string]interface{}{"spreadsheet_id": "test", "banded_range_id": 1, "header_color": "#1A73E8"}},
+		{"delete_banding", map[string]interface{}{"spreadsheet_id": "test", "banded_range_id": 1}},
+		{"create_developer_metadata", map[string]interface{}{"spreadsheet_id": "test", "key": "record_id", "value": "R-1", "dimension": "ROWS", "start": "2"}},
+		{"search_developer_metadata", map[string]interface{}{"spreadsheet_id": "test", "key": "record_id"}},
+		{"delete_developer_metadata", map[string]interface{}{"spreadsheet_id": "test", "metadata_id": 1}},
+		{"read_by_metadata", map[string]interface{}{"spreadsheet_id": "test", "key": "record_id", "value": "R-1"}},
+		{"copy_sheet_to", map[string]interface{}{"spreadsheet_id": "test", "sheet": "Sheet1", "destination_spreadsheet_id": "dest"}},
+		{"list_spreadsheets", map[string]interface{}{}},
+		{"search_spreadsheets", map[string]interface{}{"name_contains": "Budget"}},
+		{"copy_spreadsheet", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"rename_spreadsheet", map[string]interface{}{"spreadsheet_id": "test", "name": "Renamed"}},
+		{"move_spreadsheet", map[string]interface{}{"spreadsheet_id": "test", "folder_id": "folder"}},
+		{"trash_spreadsheet", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"restore_spreadsheet", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"share_spreadsheet", map[string]interface{}{"spreadsheet_id": "test", "type": "user", "role": "reader", "email_address": "bob@example.com"}},
+		{"list_permissions", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"update_permission", map[string]interface{}{"spreadsheet_id": "test", "permission": "bob@example.com", "role": "writer"}},
+		{"revoke_permission", map[string]interface{}{"spreadsheet_id": "test", "permission": "bob@example.com"}},
+		{"list_revisions", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"export_revision", map[string]interface{}{"spreadsheet_id": "test", "revision_id": "1"}},
+		{"list_comments", map[string]interface{}{"spreadsheet_id": "test"}},
+		{"create_comment", map[string]interface{}{"spreadsheet_id": "test", "content": "Please review"}},
+		{"reply_to_comment", map[string]interface{}{"spreadsheet_id": "test", "comment_id": "c1", "content": "Done"}},
+		{"resolve_comment", map[string]interface{}{"spreadsheet_id": "test", "comment_id": "c1"}},
+		{"export_pdf", map[string]interface{}{"spreadsheet_id": "test", "orientation": "landscape"}},
+		{"create_from_template", map[string]interface{}{"template_id": "template", "name": "Invoice 42", "values": map[string]interface{}{"customer": "Acme"}}},
+		{"diff_range", map[string]interface{}{"spreadsheet_id": "test", "range": "Sheet1!A1", "values": [][]string{{"a"}}}},
 	}
 
 	for _, tool := range tools {
@@ -1224,6 +2160,30 @@ func TestAllToolsSchemasAreValid(t *testing.T) {
 	}
 }
 
+func TestHandleToolsList_DryRun(t *testing.T) {
+	server := &MCPServer{ctx: context.Background()}
+	resp := server.handleToolsList(MCPRequest{})
+
+	tools := resp.Result.(map[string]interface{})["tools"].([]map[string]interface{})
+	listed := make(map[string]bool, len(tools))
+	for _, tool := range tools {
+		name := tool["name"].(string)
+		listed[name] = true
+
+		properties := tool["inputSchema"].(map[string]interface{})["properties"].(map[string]interface{})
+		_, hasDryRun := properties["dry_run"]
+		if hasDryRun != mutatingTools[name] {
+			t.Errorf("Tool %s: dry_run property present = %v, mutating = %v", name, hasDryRun, mutatingTools[name])
+		}
+	}
+
+	for name := range mutatingTools {
+		if !listed[name] {
+			t.Errorf("Mutating tool %s is not listed", name)
+		}
+	}
+}
+
 // Mock implementation of error from sheets client
 type mockError struct {
 	message string
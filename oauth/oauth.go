@@ -12,6 +12,7 @@ import (
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/sheets/v4"
 )
 
@@ -129,7 +130,8 @@ func getTokenFilePath() string {
 	return filepath.Join(configDir, TokenFileName)
 }
 
-// GetOAuthConfig returns an OAuth2 config for Google Sheets
+// GetOAuthConfig returns an OAuth2 config for Google Sheets, with Drive access
+// for finding, copying and organising spreadsheet files
 func (c *Config) GetOAuthConfig() *oauth2.Config {
 	return &oauth2.Config{
 		ClientID:     c.ClientID,
@@ -137,6 +139,7 @@ func (c *Config) GetOAuthConfig() *oauth2.Config {
 		RedirectURL:  c.RedirectURI,
 		Scopes: []string{
 			sheets.SpreadsheetsScope,
+			drive.DriveScope,
 		},
 		Endpoint: google.Endpoint,
 	}
@@ -0,0 +1,365 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// a1Range is a parsed A1 notation range. Start and end indexes are zero-based
+// and the end indexes are exclusive, matching sheets.GridRange. A nil bound
+// means the range is unbounded in that direction (e.g. "A:A", "1:3" or the
+// end of "A5:A").
+type a1Range struct {
+	SheetName   string
+	StartRow    *int64
+	EndRow      *int64
+	StartColumn *int64
+	EndColumn   *int64
+}
+
+// columnToIndex converts a column letter (e.g. "A", "AB") to a zero-based index
+func columnToIndex(column string) (int64, error) {
+	column = strings.ToUpper(strings.TrimSpace(column))
+	if column == "" {
+		return 0, fmt.Errorf("empty column")
+	}
+
+	var index int64
+	for _, ch := range column {
+		if ch < 'A' || ch > 'Z' {
+			return 0, fmt.Errorf("invalid column %q", column)
+		}
+		index = index*26 + int64(ch-'A'+1)
+	}
+	return index - 1, nil
+}
+
+// indexToColumn converts a zero-based column index to its letter form
+func indexToColumn(index int64) string {
+	var column []byte
+	for index >= 0 {
+		column = append([]byte{byte('A' + index%26)}, column...)
+		index = index/26 - 1
+	}
+	return string(column)
+}
+
+// cellAddress returns the A1 address of a zero-based row and column
+func cellAddress(row, column int64) string {
+	return fmt.Sprintf("%s%d", indexToColumn(column), row+1)
+}
+
+// splitSheetName splits "Sheet1!A1:B2" into its sheet name and cell part.
+// Quoted sheet names ('My Sheet'!A1) are unquoted.
+func splitSheetName(rangeA1 string) (string, string) {
+	idx := strings.LastIndex(rangeA1, "!")
+	if idx < 0 {
+		return "", rangeA1
+	}
+
+	sheetName := rangeA1[:idx]
+	if len(sheetName) >= 2 && strings.HasPrefix(sheetName, "'") && strings.HasSuffix(sheetName, "'") {
+		sheetName = strings.ReplaceAll(sheetName[1:len(sheetName)-1], "''", "'")
+	}
+	return sheetName, rangeA1[idx+1:]
+}
+
+// quoteSheetName quotes a sheet name for use in A1 notation when required
+func quoteSheetName(name string) string {
+	for _, ch := range name {
+		if !(ch >= 'A' && ch <= 'Z' || ch >= 'a' && ch <= 'z' || ch >= '0' && ch <= '9' || ch == '_') {
+			return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+		}
+	}
+	return name
+}
+
+// parseCell parses a single cell reference such as "B3", "B" or "3"
+func parseCell(cell string) (row *int64, column *int64, err error) {
+	cell = strings.ReplaceAll(strings.TrimSpace(cell), "$", "")
+	if cell == "" {
+		return nil, nil, fmt.Errorf("empty cell reference")
+	}
+
+	i := 0
+	for i < len(cell) && (cell[i] >= 'A' && cell[i] <= 'Z' || cell[i] >= 'a' && cell[i] <= 'z') {
+		i++
+	}
+
+	if i > 0 {
+		col, err := columnToIndex(cell[:i])
+		if err != nil {
+			return nil, nil, err
+		}
+		column = &col
+	}
+
+	if i < len(cell) {
+		n, err := strconv.ParseInt(cell[i:], 10, 64)
+		if err != nil || n < 1 {
+			return nil, nil, fmt.Errorf("invalid cell reference %q", cell)
+		}
+		r := n - 1
+		row = &r
+	}
+
+	return row, column, nil
+}
+
+// parseA1 parses A1 notation such as "Sheet1!A1:D10", "A:C", "2:5" or "'My Sheet'!B2"
+func parseA1(rangeA1 string) (*a1Range, error) {
+	sheetName, cells := splitSheetName(strings.TrimSpace(rangeA1))
+	result := &a1Range{SheetName: sheetName}
+	if cells == "" {
+		return result, nil
+	}
+
+	parts := strings.Split(cells, ":")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("invalid range %q", rangeA1)
+	}
+
+	startRow, startCol, err := parseCell(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid range %q: %v", rangeA1, err)
+	}
+	result.StartRow = startRow
+	result.StartColumn = startCol
+
+	endRow, endCol := startRow, startCol
+	if len(parts) == 2 {
+		endRow, endCol, err = parseCell(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %v", rangeA1, err)
+		}
+	}
+
+	if endRow != nil {
+		r := *endRow + 1
+		result.EndRow = &r
+	}
+	if endCol != nil {
+		c := *endCol + 1
+		result.EndColumn = &c
+	}
+
+	// "A:A5" style ranges start at the first row, "1:B5" style ranges at the
+	// first column
+	if result.StartRow == nil && result.EndRow != nil {
+		first := int64(0)
+		result.StartRow = &first
+	}
+	if result.StartColumn == nil && result.EndColumn != nil {
+		first := int64(0)
+		result.StartColumn = &first
+	}
+
+	return result, nil
+}
+
+// sheetProperties returns the properties of every sheet in a spreadsheet
+func (c *Client) sheetProperties(ctx context.Context, spreadsheetID string) ([]*sheets.SheetProperties, error) {
+	meta, err := c.metadata(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+	return meta.sheets, nil
+}
+
+// findSheet looks up a sheet by title or numeric sheet ID. An empty sheet
+// selects the first sheet in the spreadsheet.
+func (c *Client) findSheet(ctx context.Context, spreadsheetID, sheet string) (*sheets.SheetProperties, error) {
+	props, err := c.sheetProperties(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+	return matchSheet(props, sheet)
+}
+
+// matchSheet selects a sheet from props by title or numeric sheet ID
+func matchSheet(props []*sheets.SheetProperties, sheet string) (*sheets.SheetProperties, error) {
+	if len(props) == 0 {
+		return nil, fmt.Errorf("spreadsheet has no sheets")
+	}
+
+	if sheet == "" {
+		return props[0], nil
+	}
+
+	for _, p := range props {
+		if p.Title == sheet {
+			return p, nil
+		}
+	}
+
+	if id, err := strconv.ParseInt(sheet, 10, 64); err == nil {
+		for _, p := range props {
+			if p.SheetId == id {
+				return p, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("sheet %q not found", sheet)
+}
+
+// gridRange converts an A1 notation range into a sheets.GridRange, resolving
+// the sheet name to its sheet ID. A bare sheet title selects the whole sheet
+// and a named range resolves to the range it covers.
+func (c *Client) gridRange(ctx context.Context, spreadsheetID, rangeA1 string) (*sheets.GridRange, error) {
+	gr, _, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	return gr, err
+}
+
+// resolveRange is like gridRange but also returns the properties of the sheet
+// the range belongs to
+func (c *Client) resolveRange(ctx context.Context, spreadsheetID, rangeA1 string) (*sheets.GridRange, *sheets.SheetProperties, error) {
+	meta, err := c.metadata(ctx, spreadsheetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	props := meta.sheets
+
+	for _, p := range props {
+		if p.Title == rangeA1 && !strings.Contains(rangeA1, "!") {
+			return &sheets.GridRange{SheetId: p.SheetId, ForceSendFields: []string{"SheetId"}}, p, nil
+		}
+	}
+
+	if mayBeNamedRange(rangeA1) {
+		if nr := meta.findNamedRange(rangeA1); nr != nil && nr.Range != nil {
+			sheet, err := matchSheet(props, fmt.Sprintf("%d", nr.Range.SheetId))
+			if err != nil {
+				return nil, nil, err
+			}
+			gr := *nr.Range
+			gr.ForceSendFields = []string{"SheetId"}
+			return &gr, sheet, nil
+		}
+	}
+
+	parsed, err := parseA1(rangeA1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sheet, err := matchSheet(props, parsed.SheetName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gr := &sheets.GridRange{SheetId: sheet.SheetId, ForceSendFields: []string{"SheetId"}}
+	if parsed.StartRow != nil {
+		gr.StartRowIndex = *parsed.StartRow
+		gr.ForceSendFields = append(gr.ForceSendFields, "StartRowIndex")
+	}
+	if parsed.EndRow != nil {
+		gr.EndRowIndex = *parsed.EndRow
+		gr.ForceSendFields = append(gr.ForceSendFields, "EndRowIndex")
+	}
+	if parsed.StartColumn != nil {
+		gr.StartColumnIndex = *parsed.StartColumn
+		gr.ForceSendFields = append(gr.ForceSendFields, "StartColumnIndex")
+	}
+	if parsed.EndColumn != nil {
+		gr.EndColumnIndex = *parsed.EndColumn
+		gr.ForceSendFields = append(gr.ForceSendFields, "EndColumnIndex")
+	}
+	return gr, sheet, nil
+}
+
+// gridCoordinate converts a single A1 cell (e.g. "Sheet1!E2") into a sheets.GridCoordinate
+func (c *Client) gridCoordinate(ctx context.Context, spreadsheetID, cellA1 string) (*sheets.GridCoordinate, error) {
+	gr, err := c.gridRange(ctx, spreadsheetID, cellA1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sheets.GridCoordinate{
+		SheetId:         gr.SheetId,
+		RowIndex:        gr.StartRowIndex,
+		ColumnIndex:     gr.StartColumnIndex,
+		ForceSendFields: []string{"SheetId", "RowIndex", "ColumnIndex"},
+	}, nil
+}
+
+// formatGridRange renders a sheets.GridRange back into A1 notation
+func formatGridRange(sheetName string, gr *sheets.GridRange) string {
+	if gr == nil {
+		return ""
+	}
+
+	prefix := ""
+	if sheetName != "" {
+		prefix = quoteSheetName(sheetName) + "!"
+	}
+
+	hasRows := gr.EndRowIndex > 0
+	hasCols := gr.EndColumnIndex > 0
+
+	switch {
+	case hasRows && hasCols:
+		return fmt.Sprintf("%s%s:%s", prefix,
+			cellAddress(gr.StartRowIndex, gr.StartColumnIndex),
+			cellAddress(gr.EndRowIndex-1, gr.EndColumnIndex-1))
+	case hasCols && gr.StartRowIndex > 0:
+		// "B2:D" runs to the last row
+		return fmt.Sprintf("%s%s:%s", prefix, cellAddress(gr.StartRowIndex, gr.StartColumnIndex), indexToColumn(gr.EndColumnIndex-1))
+	case hasCols:
+		return fmt.Sprintf("%s%s:%s", prefix, indexToColumn(gr.StartColumnIndex), indexToColumn(gr.EndColumnIndex-1))
+	case hasRows && gr.StartColumnIndex > 0:
+		// "B2:5" runs to the last column
+		return fmt.Sprintf("%s%s:%d", prefix, cellAddress(gr.StartRowIndex, gr.StartColumnIndex), gr.EndRowIndex)
+	case hasRows:
+		return fmt.Sprintf("%s%d:%d", prefix, gr.StartRowIndex+1, gr.EndRowIndex)
+	default:
+		return strings.TrimSuffix(prefix, "!")
+	}
+}
+
+// headerRow reads the first row of a grid range, which holds the column headers
+func (c *Client) headerRow(ctx context.Context, spreadsheetID string, gr *sheets.GridRange, sheetTitle string) ([]string, error) {
+	header := &sheets.GridRange{
+		SheetId:          gr.SheetId,
+		StartRowIndex:    gr.StartRowIndex,
+		EndRowIndex:      gr.StartRowIndex + 1,
+		StartColumnIndex: gr.StartColumnIndex,
+		EndColumnIndex:   gr.EndColumnIndex,
+	}
+
+	resp, err := c.service.Spreadsheets.Values.Get(spreadsheetID, formatGridRange(sheetTitle, header)).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read header row: %v", err)
+	}
+
+	if len(resp.Values) == 0 {
+		return []string{}, nil
+	}
+
+	headers := make([]string, len(resp.Values[0]))
+	for i, cell := range resp.Values[0] {
+		headers[i] = fmt.Sprintf("%v", cell)
+	}
+	return headers, nil
+}
+
+// columnOffset resolves a column given by header name, or failing that by
+// column letter, to its offset from the first column of gr
+func columnOffset(headers []string, gr *sheets.GridRange, column string) (int64, error) {
+	for i, h := range headers {
+		if strings.EqualFold(strings.TrimSpace(h), strings.TrimSpace(column)) {
+			return int64(i), nil
+		}
+	}
+
+	if index, err := columnToIndex(column); err == nil && index >= gr.StartColumnIndex &&
+		(gr.EndColumnIndex == 0 || index < gr.EndColumnIndex) {
+		return index - gr.StartColumnIndex, nil
+	}
+
+	return 0, fmt.Errorf("column %q not found in header row (available: %s)", column, strings.Join(headers, ", "))
+}
@@ -0,0 +1,158 @@
+package sheets
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestColumnToIndex(t *testing.T) {
+	tests := []struct {
+		column string
+		want   int64
+	}{
+		{"A", 0},
+		{"Z", 25},
+		{"AA", 26},
+		{"az", 51},
+		{"ZZ", 701},
+		{"AAA", 702},
+	}
+
+	for _, tt := range tests {
+		got, err := columnToIndex(tt.column)
+		if err != nil {
+			t.Errorf("columnToIndex(%q) returned error: %v", tt.column, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("columnToIndex(%q) = %d, want %d", tt.column, got, tt.want)
+		}
+		if back := indexToColumn(got); back != strings.ToUpper(tt.column) {
+			t.Errorf("indexToColumn(%d) = %q, want %q", got, back, strings.ToUpper(tt.column))
+		}
+	}
+
+	if _, err := columnToIndex("A1"); err == nil {
+		t.Error("Expected error for invalid column")
+	}
+}
+
+func TestParseA1(t *testing.T) {
+	i := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		input    string
+		sheet    string
+		startRow *int64
+		endRow   *int64
+		startCol *int64
+		endCol   *int64
+	}{
+		{"Sheet1!A1:D10", "Sheet1", i(0), i(10), i(0), i(4)},
+		{"B2", "", i(1), i(2), i(1), i(2)},
+		{"'My Sheet'!C:E", "My Sheet", nil, nil, i(2), i(5)},
+		{"Data!2:5", "Data", i(1), i(5), nil, nil},
+		{"'It''s'!$A$1", "It's", i(0), i(1), i(0), i(1)},
+		{"Data!", "Data", nil, nil, nil, nil},
+		{"Sheet1!B2:D", "Sheet1", i(1), nil, i(1), i(4)},
+		{"A5:A", "", i(4), nil, i(0), i(1)},
+		{"A1:5", "", i(0), i(5), i(0), nil},
+		{"A:A5", "", i(0), i(5), i(0), i(1)},
+	}
+
+	eq := func(a, b *int64) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		return *a == *b
+	}
+
+	for _, tt := range tests {
+		got, err := parseA1(tt.input)
+		if err != nil {
+			t.Errorf("parseA1(%q) returned error: %v", tt.input, err)
+			continue
+		}
+		if got.SheetName != tt.sheet {
+			t.Errorf("parseA1(%q) sheet = %q, want %q", tt.input, got.SheetName, tt.sheet)
+		}
+		if !eq(got.StartRow, tt.startRow) || !eq(got.EndRow, tt.endRow) ||
+			!eq(got.StartColumn, tt.startCol) || !eq(got.EndColumn, tt.endCol) {
+			t.Errorf("parseA1(%q) bounds mismatch: %+v", tt.input, got)
+		}
+	}
+
+	if _, err := parseA1("A1:B2:C3"); err == nil {
+		t.Error("Expected error for range with too many parts")
+	}
+}
+
+func TestGridRange_HalfOpen(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	tests := []struct {
+		input                              string
+		startRow, endRow, startCol, endCol int64
+		formatted                          string
+	}{
+		{"Sheet1!B2:D", 1, 0, 1, 4, "Sheet1!B2:D"},
+		{"A5:A", 4, 0, 0, 1, "Sheet1!A5:A"},
+		{"A1:5", 0, 5, 0, 0, "Sheet1!1:5"},
+		{"C2:5", 1, 5, 2, 0, "Sheet1!C2:5"},
+		{"A:A5", 0, 5, 0, 1, "Sheet1!A1:A5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			gr, err := client.gridRange(context.Background(), "test-spreadsheet-id", tt.input)
+			if err != nil {
+				t.Fatalf("gridRange failed: %v", err)
+			}
+			if gr.StartRowIndex != tt.startRow || gr.EndRowIndex != tt.endRow ||
+				gr.StartColumnIndex != tt.startCol || gr.EndColumnIndex != tt.endCol {
+				t.Errorf("Unexpected grid range: %+v", gr)
+			}
+			if got := formatGridRange("Sheet1", gr); got != tt.formatted {
+				t.Errorf("formatGridRange = %q, want %q", got, tt.formatted)
+			}
+		})
+	}
+}
+
+func TestGridRange_ResolvesSheetID(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Data"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	gr, err := client.gridRange(context.Background(), "test-spreadsheet-id", "Data!B2:C5")
+	if err != nil {
+		t.Fatalf("gridRange failed: %v", err)
+	}
+
+	if gr.SheetId != 1 {
+		t.Errorf("Expected sheet ID 1, got %d", gr.SheetId)
+	}
+	if gr.StartRowIndex != 1 || gr.EndRowIndex != 5 || gr.StartColumnIndex != 1 || gr.EndColumnIndex != 3 {
+		t.Errorf("Unexpected grid range: %+v", gr)
+	}
+
+	if got := formatGridRange("Data", gr); got != "Data!B2:C5" {
+		t.Errorf("formatGridRange = %q, want 'Data!B2:C5'", got)
+	}
+
+	whole, err := client.gridRange(context.Background(), "test-spreadsheet-id", "Data")
+	if err != nil {
+		t.Fatalf("gridRange for bare sheet title failed: %v", err)
+	}
+	if whole.SheetId != 1 || whole.EndRowIndex != 0 || whole.EndColumnIndex != 0 {
+		t.Errorf("Expected whole-sheet range on sheet 1, got %+v", whole)
+	}
+
+	if _, err := client.gridRange(context.Background(), "test-spreadsheet-id", "Missing!A1"); err == nil {
+		t.Error("Expected error for unknown sheet")
+	}
+}
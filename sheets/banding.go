@@ -0,0 +1,297 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// Default band colors used when a new banded range does not specify them
+const (
+	defaultFirstBandColor  = "#FFFFFF"
+	defaultSecondBandColor = "#F3F3F3"
+)
+
+// BandingOptions describes alternating colors over a range. Colors are hex
+// strings; on update, empty fields are left unchanged. Columns bands columns
+// instead of rows and only applies on creation.
+type BandingOptions struct {
+	Range           string
+	HeaderColor     string
+	FirstBandColor  string
+	SecondBandColor string
+	FooterColor     string
+	Columns         bool
+}
+
+// bandedRangeInfo summarises a banded range for tool output
+func bandedRangeInfo(br *sheets.BandedRange, titles map[int64]string) map[string]interface{} {
+	info := map[string]interface{}{
+		"banded_range_id": br.BandedRangeId,
+	}
+	if br.Range != nil {
+		info["range"] = formatGridRange(titles[br.Range.SheetId], br.Range)
+	}
+
+	props := br.RowProperties
+	info["orientation"] = "ROWS"
+	if props == nil && br.ColumnProperties != nil {
+		props = br.ColumnProperties
+		info["orientation"] = "COLUMNS"
+	}
+	if props != nil {
+		colors := map[string]string{
+			"header_color":      hexColorStyle(props.HeaderColorStyle),
+			"first_band_color":  hexColorStyle(props.FirstBandColorStyle),
+			"second_band_color": hexColorStyle(props.SecondBandColorStyle),
+			"footer_color":      hexColorStyle(props.FooterColorStyle),
+		}
+		for key, color := range colors {
+			if color != "" {
+				info[key] = color
+			}
+		}
+	}
+	return info
+}
+
+// bandingProperties converts the colors in opts into sheets.BandingProperties
+// and the field names that were set, relative to the properties object
+func bandingProperties(opts BandingOptions) (*sheets.BandingProperties, []string, error) {
+	props := &sheets.BandingProperties{}
+	var fields []string
+
+	colors := []struct {
+		hex   string
+		field string
+		set   func(*sheets.ColorStyle)
+	}{
+		{opts.HeaderColor, "headerColorStyle", func(c *sheets.ColorStyle) { props.HeaderColorStyle = c }},
+		{opts.FirstBandColor, "firstBandColorStyle", func(c *sheets.ColorStyle) { props.FirstBandColorStyle = c }},
+		{opts.SecondBandColor, "secondBandColorStyle", func(c *sheets.ColorStyle) { props.SecondBandColorStyle = c }},
+		{opts.FooterColor, "footerColorStyle", func(c *sheets.ColorStyle) { props.FooterColorStyle = c }},
+	}
+
+	for _, color := range colors {
+		if color.hex == "" {
+			continue
+		}
+		style, err := colorStyle(color.hex)
+		if err != nil {
+			return nil, nil, err
+		}
+		color.set(style)
+		fields = append(fields, color.field)
+	}
+
+	return props, fields, nil
+}
+
+// findBandedRange returns an existing banded range by ID
+func (c *Client) findBandedRange(ctx context.Context, spreadsheetID string, bandedRangeID int64) (*sheets.BandedRange, error) {
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.bandedRanges").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve banded ranges: %v", err)
+	}
+
+	for _, sheet := range resp.Sheets {
+		for _, br := range sheet.BandedRanges {
+			if br.BandedRangeId == bandedRangeID {
+				return br, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("banded range %d not found", bandedRangeID)
+}
+
+// AddBanding applies alternating row (or column) colors to a range
+func (c *Client) AddBanding(ctx context.Context, spreadsheetID string, opts BandingOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if opts.Range == "" {
+		return nil, fmt.Errorf("range is required")
+	}
+	if opts.FirstBandColor == "" {
+		opts.FirstBandColor = defaultFirstBandColor
+	}
+	if opts.SecondBandColor == "" {
+		opts.SecondBandColor = defaultSecondBandColor
+	}
+
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, opts.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	props, _, err := bandingProperties(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	banded := &sheets.BandedRange{Range: gr}
+	if opts.Columns {
+		banded.ColumnProperties = props
+	} else {
+		banded.RowProperties = props
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				AddBanding: &sheets.AddBandingRequest{BandedRange: banded},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to add banding: %v", err)
+	}
+
+	result := bandedRangeInfo(banded, map[int64]string{sheet.SheetId: sheet.Title})
+	delete(result, "banded_range_id")
+	if len(resp.Replies) > 0 && resp.Replies[0].AddBanding != nil && resp.Replies[0].AddBanding.BandedRange != nil {
+		result["banded_range_id"] = resp.Replies[0].AddBanding.BandedRange.BandedRangeId
+	}
+	result["message"] = "Banding added successfully"
+	return result, nil
+}
+
+// ListBandedRanges lists banded ranges, optionally limited to a single sheet
+func (c *Client) ListBandedRanges(ctx context.Context, spreadsheetID, sheet string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties", "sheets.bandedRanges").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve banded ranges: %v", err)
+	}
+
+	titles := sheetTitles(resp.Sheets)
+	banded := []map[string]interface{}{}
+	for _, s := range resp.Sheets {
+		if sheet != "" && s.Properties != nil && s.Properties.Title != sheet && fmt.Sprintf("%d", s.Properties.SheetId) != sheet {
+			continue
+		}
+		for _, br := range s.BandedRanges {
+			banded = append(banded, bandedRangeInfo(br, titles))
+		}
+	}
+
+	return map[string]interface{}{
+		"banded_ranges": banded,
+		"count":         len(banded),
+	}, nil
+}
+
+// UpdateBanding changes the range or colors of an existing banded range,
+// keeping its row or column orientation
+func (c *Client) UpdateBanding(ctx context.Context, spreadsheetID string, bandedRangeID int64, opts BandingOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	props, colorFields, err := bandingProperties(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	banded := &sheets.BandedRange{BandedRangeId: bandedRangeID}
+	var fields []string
+
+	if opts.Range != "" {
+		if banded.Range, err = c.gridRange(ctx, spreadsheetID, opts.Range); err != nil {
+			return nil, err
+		}
+		fields = append(fields, "range")
+	}
+
+	if len(colorFields) > 0 {
+		existing, err := c.findBandedRange(ctx, spreadsheetID, bandedRangeID)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix := "rowProperties."
+		if existing.RowProperties == nil && existing.ColumnProperties != nil {
+			prefix = "columnProperties."
+			banded.ColumnProperties = props
+		} else {
+			banded.RowProperties = props
+		}
+		for _, f := range colorFields {
+			fields = append(fields, prefix+f)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no banding changes specified")
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				UpdateBanding: &sheets.UpdateBandingRequest{
+					BandedRange: banded,
+					Fields:      strings.Join(fields, ","),
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to update banding: %v", err)
+	}
+
+	return map[string]interface{}{
+		"banded_range_id": bandedRangeID,
+		"updated_fields":  fields,
+		"message":         "Banding updated successfully",
+	}, nil
+}
+
+// DeleteBanding removes a banded range. Cell values are left unchanged.
+func (c *Client) DeleteBanding(ctx context.Context, spreadsheetID string, bandedRangeID int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				DeleteBanding: &sheets.DeleteBandingRequest{
+					BandedRangeId:   bandedRangeID,
+					ForceSendFields: []string{"BandedRangeId"},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to delete banding: %v", err)
+	}
+
+	return map[string]interface{}{
+		"banded_range_id": bandedRangeID,
+		"message":         "Banding deleted successfully",
+	}, nil
+}
@@ -0,0 +1,118 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAddBanding_Defaults(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{AddBanding: &sheets.AddBandingResponse{BandedRange: &sheets.BandedRange{BandedRangeId: 5}}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.AddBanding(context.Background(), "test-spreadsheet-id", BandingOptions{
+		Range:       "Sheet1!A1:D20",
+		HeaderColor: "#1A73E8",
+	})
+	if err != nil {
+		t.Fatalf("AddBanding failed: %v", err)
+	}
+
+	banded := received.Requests[0].AddBanding.BandedRange
+	if banded.RowProperties == nil || banded.ColumnProperties != nil {
+		t.Fatalf("Expected row banding, got %+v", banded)
+	}
+	props := banded.RowProperties
+	if hexColorStyle(props.HeaderColorStyle) != "#1A73E8" || hexColorStyle(props.FirstBandColorStyle) != defaultFirstBandColor ||
+		hexColorStyle(props.SecondBandColorStyle) != defaultSecondBandColor || props.FooterColorStyle != nil {
+		t.Errorf("Unexpected banding properties: %+v", props)
+	}
+
+	info := result.(map[string]interface{})
+	if info["banded_range_id"] != int64(5) || info["range"] != "Sheet1!A1:D20" {
+		t.Errorf("Unexpected result: %v", info)
+	}
+
+	if _, err := client.AddBanding(context.Background(), "test-spreadsheet-id", BandingOptions{Range: "Sheet1!A1:D20", FirstBandColor: "blue"}); err == nil {
+		t.Error("Expected error for invalid color")
+	}
+}
+
+func TestUpdateBanding_ColumnOrientation(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sheet1")
+	spreadsheet.Sheets[0].BandedRanges = []*sheets.BandedRange{
+		{BandedRangeId: 5, Range: &sheets.GridRange{SheetId: 0, EndRowIndex: 10, EndColumnIndex: 4}, ColumnProperties: &sheets.BandingProperties{}},
+	}
+
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, spreadsheet, func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.UpdateBanding(context.Background(), "test-spreadsheet-id", 5, BandingOptions{SecondBandColor: "#EEEEEE"}); err != nil {
+		t.Fatalf("UpdateBanding failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateBanding
+	if update.Fields != "columnProperties.secondBandColorStyle" || update.BandedRange.ColumnProperties == nil {
+		t.Errorf("Unexpected update: fields=%q banded=%+v", update.Fields, update.BandedRange)
+	}
+
+	if _, err := client.UpdateBanding(context.Background(), "test-spreadsheet-id", 5, BandingOptions{}); err == nil {
+		t.Error("Expected error when no changes are specified")
+	}
+}
+
+func TestListBandedRanges_Info(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sheet1")
+	spreadsheet.Sheets[0].BandedRanges = []*sheets.BandedRange{
+		{
+			BandedRangeId: 5,
+			Range:         &sheets.GridRange{SheetId: 0, EndRowIndex: 10, EndColumnIndex: 4},
+			RowProperties: &sheets.BandingProperties{
+				FirstBandColorStyle:  &sheets.ColorStyle{RgbColor: &sheets.Color{Red: 1, Green: 1, Blue: 1}},
+				SecondBandColorStyle: &sheets.ColorStyle{RgbColor: &sheets.Color{}},
+			},
+		},
+	}
+
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.ListBandedRanges(context.Background(), "test-spreadsheet-id", "")
+	if err != nil {
+		t.Fatalf("ListBandedRanges failed: %v", err)
+	}
+
+	banded := result.(map[string]interface{})["banded_ranges"].([]map[string]interface{})
+	if len(banded) != 1 {
+		t.Fatalf("Expected 1 banded range, got %d", len(banded))
+	}
+	if banded[0]["range"] != "Sheet1!A1:D10" || banded[0]["orientation"] != "ROWS" || banded[0]["first_band_color"] != "#FFFFFF" || banded[0]["second_band_color"] != "#000000" {
+		t.Errorf("Unexpected banded range info: %v", banded[0])
+	}
+	if _, ok := banded[0]["header_color"]; ok {
+		t.Error("Expected no header color")
+	}
+}
@@ -0,0 +1,188 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// cellFields is the field mask used to read cell values, formatting, notes and validation
+const cellFields = "sheets(properties(title),data(startRow,startColumn,rowData(values(userEnteredValue,effectiveValue,formattedValue,effectiveFormat(numberFormat,textFormat,backgroundColorStyle,horizontalAlignment,verticalAlignment,wrapStrategy),note,hyperlink,dataValidation))))"
+
+// extendedValue converts a sheets.ExtendedValue to a plain Go value
+func extendedValue(v *sheets.ExtendedValue) interface{} {
+	switch {
+	case v == nil:
+		return nil
+	case v.NumberValue != nil:
+		return *v.NumberValue
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return *v.BoolValue
+	case v.FormulaValue != nil:
+		return *v.FormulaValue
+	case v.ErrorValue != nil:
+		return map[string]interface{}{
+			"error":   v.ErrorValue.Type,
+			"message": v.ErrorValue.Message,
+		}
+	default:
+		return nil
+	}
+}
+
+// textFormatInfo summarises the non-default parts of a text format
+func textFormatInfo(tf *sheets.TextFormat) map[string]interface{} {
+	info := map[string]interface{}{}
+	if tf == nil {
+		return info
+	}
+	if tf.Bold {
+		info["bold"] = true
+	}
+	if tf.Italic {
+		info["italic"] = true
+	}
+	if tf.Underline {
+		info["underline"] = true
+	}
+	if tf.Strikethrough {
+		info["strikethrough"] = true
+	}
+	if tf.FontFamily != "" {
+		info["font_family"] = tf.FontFamily
+	}
+	if tf.FontSize != 0 {
+		info["font_size"] = tf.FontSize
+	}
+	if color := hexColorStyle(tf.ForegroundColorStyle); color != "" {
+		info["color"] = color
+	}
+	return info
+}
+
+// dataValidationInfo summarises a data validation rule
+func dataValidationInfo(rule *sheets.DataValidationRule) map[string]interface{} {
+	info := map[string]interface{}{
+		"strict": rule.Strict,
+	}
+	if rule.Condition != nil {
+		info["condition"] = rule.Condition.Type
+		if values := conditionValues(rule.Condition); len(values) > 0 {
+			info["values"] = values
+		}
+	}
+	if rule.InputMessage != "" {
+		info["input_message"] = rule.InputMessage
+	}
+	if rule.ShowCustomUi {
+		info["show_dropdown"] = true
+	}
+	return info
+}
+
+// cellInfo describes a single cell for read_cells. It returns nil for cells
+// with no value, formatting, note or validation.
+func cellInfo(address string, cell *sheets.CellData) map[string]interface{} {
+	if cell.UserEnteredValue == nil && cell.EffectiveValue == nil && cell.EffectiveFormat == nil &&
+		cell.Note == "" && cell.DataValidation == nil {
+		return nil
+	}
+
+	info := map[string]interface{}{
+		"cell":            address,
+		"formatted_value": cell.FormattedValue,
+		"effective_value": extendedValue(cell.EffectiveValue),
+	}
+
+	if cell.UserEnteredValue != nil && cell.UserEnteredValue.FormulaValue != nil {
+		info["formula"] = *cell.UserEnteredValue.FormulaValue
+	}
+
+	if format := cell.EffectiveFormat; format != nil {
+		if nf := format.NumberFormat; nf != nil {
+			info["number_format"] = map[string]interface{}{
+				"type":    nf.Type,
+				"pattern": nf.Pattern,
+			}
+		}
+		if style := textFormatInfo(format.TextFormat); len(style) > 0 {
+			info["text_style"] = style
+		}
+		if background := hexColorStyle(format.BackgroundColorStyle); background != "" {
+			info["background_color"] = background
+		}
+		if format.HorizontalAlignment != "" {
+			info["horizontal_alignment"] = format.HorizontalAlignment
+		}
+		if format.VerticalAlignment != "" {
+			info["vertical_alignment"] = format.VerticalAlignment
+		}
+		if format.WrapStrategy != "" {
+			info["wrap_strategy"] = format.WrapStrategy
+		}
+	}
+
+	if cell.Note != "" {
+		info["note"] = cell.Note
+	}
+	if cell.Hyperlink != "" {
+		info["hyperlink"] = cell.Hyperlink
+	}
+	if cell.DataValidation != nil {
+		info["data_validation"] = dataValidationInfo(cell.DataValidation)
+	}
+
+	return info
+}
+
+// ReadCells returns structured details for every non-empty cell in a range:
+// effective and formatted values, formula, number format, text style,
+// background color, note and data validation
+func (c *Client) ReadCells(ctx context.Context, spreadsheetID, rangeA1 string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if rangeA1 == "" {
+		return nil, fmt.Errorf("range is required")
+	}
+
+	resolved, err := c.resolveA1(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Ranges(resolved).IncludeGridData(true).Fields(cellFields).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve cells: %v", err)
+	}
+
+	cells := []map[string]interface{}{}
+	for _, sheet := range resp.Sheets {
+		title := ""
+		if sheet.Properties != nil {
+			title = sheet.Properties.Title
+		}
+		prefix := quoteSheetName(title) + "!"
+
+		for _, data := range sheet.Data {
+			for r, row := range data.RowData {
+				for col, cell := range row.Values {
+					address := prefix + cellAddress(data.StartRow+int64(r), data.StartColumn+int64(col))
+					if info := cellInfo(address, cell); info != nil {
+						cells = append(cells, info)
+					}
+				}
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"range": resolved,
+		"cells": cells,
+		"count": len(cells),
+	}, nil
+}
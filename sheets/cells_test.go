@@ -0,0 +1,102 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestReadCells_Details(t *testing.T) {
+	total := 1234.5
+	formula := "=SUM(B2:B9)"
+	label := "Total"
+
+	spreadsheet := testSpreadsheet("Report")
+	spreadsheet.Sheets[0].Data = []*sheets.GridData{
+		{
+			StartRow:    9,
+			StartColumn: 0,
+			RowData: []*sheets.RowData{
+				{Values: []*sheets.CellData{
+					{
+						UserEnteredValue: &sheets.ExtendedValue{StringValue: &label},
+						EffectiveValue:   &sheets.ExtendedValue{StringValue: &label},
+						FormattedValue:   "Total",
+						EffectiveFormat: &sheets.CellFormat{
+							TextFormat: &sheets.TextFormat{Bold: true},
+						},
+						Note: "Checked by finance",
+					},
+					{
+						UserEnteredValue: &sheets.ExtendedValue{FormulaValue: &formula},
+						EffectiveValue:   &sheets.ExtendedValue{NumberValue: &total},
+						FormattedValue:   "$1,234.50",
+						EffectiveFormat: &sheets.CellFormat{
+							NumberFormat:         &sheets.NumberFormat{Type: "CURRENCY", Pattern: "$#,##0.00"},
+							BackgroundColorStyle: &sheets.ColorStyle{RgbColor: &sheets.Color{Red: 1, Green: 1}},
+						},
+						DataValidation: &sheets.DataValidationRule{
+							Condition: &sheets.BooleanCondition{Type: "NUMBER_GREATER", Values: []*sheets.ConditionValue{{UserEnteredValue: "0"}}},
+							Strict:    true,
+						},
+					},
+					{},
+				}},
+			},
+		},
+	}
+
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.ReadCells(context.Background(), "test-spreadsheet-id", "Report!A10:C10")
+	if err != nil {
+		t.Fatalf("ReadCells failed: %v", err)
+	}
+
+	cells := result.(map[string]interface{})["cells"].([]map[string]interface{})
+	if len(cells) != 2 {
+		t.Fatalf("Expected empty cell to be skipped, got %d cells", len(cells))
+	}
+
+	label0 := cells[0]
+	if label0["cell"] != "Report!A10" || label0["note"] != "Checked by finance" {
+		t.Errorf("Unexpected first cell: %v", label0)
+	}
+	if style := label0["text_style"].(map[string]interface{}); style["bold"] != true {
+		t.Errorf("Expected bold text style, got %v", style)
+	}
+
+	sum := cells[1]
+	if sum["formula"] != formula || sum["effective_value"] != total || sum["formatted_value"] != "$1,234.50" {
+		t.Errorf("Unexpected values: %v", sum)
+	}
+	if sum["background_color"] != "#FFFF00" {
+		t.Errorf("Unexpected background: %v", sum["background_color"])
+	}
+	if nf := sum["number_format"].(map[string]interface{}); nf["type"] != "CURRENCY" {
+		t.Errorf("Unexpected number format: %v", nf)
+	}
+	if dv := sum["data_validation"].(map[string]interface{}); dv["condition"] != "NUMBER_GREATER" || dv["strict"] != true {
+		t.Errorf("Unexpected data validation: %v", dv)
+	}
+}
+
+func TestExtendedValue_Types(t *testing.T) {
+	b := true
+	if extendedValue(&sheets.ExtendedValue{BoolValue: &b}) != true {
+		t.Error("Expected bool value")
+	}
+
+	errValue := extendedValue(&sheets.ExtendedValue{ErrorValue: &sheets.ErrorValue{Type: "DIVIDE_BY_ZERO"}})
+	if errValue.(map[string]interface{})["error"] != "DIVIDE_BY_ZERO" {
+		t.Errorf("Unexpected error value: %v", errValue)
+	}
+
+	if extendedValue(nil) != nil {
+		t.Error("Expected nil for missing value")
+	}
+}
@@ -0,0 +1,380 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ChartOptions describes a chart using the simplified form accepted by the chart tools
+type ChartOptions struct {
+	ChartType    string
+	Title        string
+	DomainRange  string
+	SeriesRanges []string
+	AnchorCell   string
+	NewSheet     bool
+}
+
+// basicChartTypes are the chart types rendered through BasicChartSpec
+var basicChartTypes = map[string]bool{
+	"LINE":    true,
+	"BAR":     true,
+	"COLUMN":  true,
+	"SCATTER": true,
+	"AREA":    true,
+}
+
+// chartSource wraps a grid range in the ChartData structure used by chart specs
+func chartSource(gr *sheets.GridRange) *sheets.ChartData {
+	return &sheets.ChartData{
+		SourceRange: &sheets.ChartSourceRange{
+			Sources: []*sheets.GridRange{gr},
+		},
+	}
+}
+
+// buildChartSpec converts ChartOptions into a sheets.ChartSpec
+func (c *Client) buildChartSpec(ctx context.Context, spreadsheetID string, opts ChartOptions) (*sheets.ChartSpec, error) {
+	chartType := strings.ToUpper(opts.ChartType)
+	if chartType != "PIE" && !basicChartTypes[chartType] {
+		return nil, fmt.Errorf("unsupported chart type %q (expected line, bar, column, area, pie or scatter)", opts.ChartType)
+	}
+
+	if opts.DomainRange == "" {
+		return nil, fmt.Errorf("domain_range is required")
+	}
+	if len(opts.SeriesRanges) == 0 {
+		return nil, fmt.Errorf("at least one series range is required")
+	}
+
+	domain, err := c.gridRange(ctx, spreadsheetID, opts.DomainRange)
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain range: %v", err)
+	}
+
+	series := make([]*sheets.GridRange, len(opts.SeriesRanges))
+	for i, r := range opts.SeriesRanges {
+		series[i], err = c.gridRange(ctx, spreadsheetID, r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid series range %q: %v", r, err)
+		}
+	}
+
+	spec := &sheets.ChartSpec{Title: opts.Title}
+
+	if chartType == "PIE" {
+		spec.PieChart = &sheets.PieChartSpec{
+			Domain:         chartSource(domain),
+			Series:         chartSource(series[0]),
+			LegendPosition: "RIGHT_LEGEND",
+		}
+		return spec, nil
+	}
+
+	// Bar charts plot values along the horizontal axis
+	targetAxis := "LEFT_AXIS"
+	if chartType == "BAR" {
+		targetAxis = "BOTTOM_AXIS"
+	}
+
+	basicSeries := make([]*sheets.BasicChartSeries, len(series))
+	for i, gr := range series {
+		basicSeries[i] = &sheets.BasicChartSeries{
+			Series:     chartSource(gr),
+			TargetAxis: targetAxis,
+		}
+	}
+
+	spec.BasicChart = &sheets.BasicChartSpec{
+		ChartType:      chartType,
+		LegendPosition: "BOTTOM_LEGEND",
+		HeaderCount:    1,
+		Domains: []*sheets.BasicChartDomain{
+			{Domain: chartSource(domain)},
+		},
+		Series: basicSeries,
+	}
+	return spec, nil
+}
+
+// chartPosition builds the embedded object position for a chart
+func (c *Client) chartPosition(ctx context.Context, spreadsheetID string, opts ChartOptions) (*sheets.EmbeddedObjectPosition, error) {
+	if opts.NewSheet {
+		return &sheets.EmbeddedObjectPosition{NewSheet: true}, nil
+	}
+
+	if opts.AnchorCell != "" {
+		anchor, err := c.gridCoordinate(ctx, spreadsheetID, opts.AnchorCell)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor cell: %v", err)
+		}
+		return &sheets.EmbeddedObjectPosition{
+			OverlayPosition: &sheets.OverlayPosition{AnchorCell: anchor},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// chartType reports the simplified chart type of an existing chart spec
+func chartType(spec *sheets.ChartSpec) string {
+	switch {
+	case spec == nil:
+		return ""
+	case spec.BasicChart != nil:
+		return spec.BasicChart.ChartType
+	case spec.PieChart != nil:
+		return "PIE"
+	case spec.HistogramChart != nil:
+		return "HISTOGRAM"
+	case spec.CandlestickChart != nil:
+		return "CANDLESTICK"
+	case spec.OrgChart != nil:
+		return "ORG"
+	case spec.TreemapChart != nil:
+		return "TREEMAP"
+	case spec.WaterfallChart != nil:
+		return "WATERFALL"
+	case spec.BubbleChart != nil:
+		return "BUBBLE"
+	case spec.ScorecardChart != nil:
+		return "SCORECARD"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// chartInfo summarises an embedded chart for tool output
+func chartInfo(chart *sheets.EmbeddedChart) map[string]interface{} {
+	info := map[string]interface{}{
+		"chart_id":   chart.ChartId,
+		"chart_type": chartType(chart.Spec),
+	}
+	if chart.Spec != nil {
+		info["title"] = chart.Spec.Title
+	}
+	if chart.Position != nil && chart.Position.OverlayPosition != nil && chart.Position.OverlayPosition.AnchorCell != nil {
+		anchor := chart.Position.OverlayPosition.AnchorCell
+		info["anchor_cell"] = cellAddress(anchor.RowIndex, anchor.ColumnIndex)
+	}
+	return info
+}
+
+// CreateChart adds a chart to a spreadsheet
+func (c *Client) CreateChart(ctx context.Context, spreadsheetID string, opts ChartOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	spec, err := c.buildChartSpec(ctx, spreadsheetID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := c.chartPosition(ctx, spreadsheetID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if position == nil {
+		// Default to placing the chart on the same sheet as its data
+		domain, err := c.gridRange(ctx, spreadsheetID, opts.DomainRange)
+		if err != nil {
+			return nil, err
+		}
+		position = &sheets.EmbeddedObjectPosition{SheetId: domain.SheetId, ForceSendFields: []string{"SheetId"}}
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				AddChart: &sheets.AddChartRequest{
+					Chart: &sheets.EmbeddedChart{
+						Spec:     spec,
+						Position: position,
+					},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create chart: %v", err)
+	}
+	if opts.NewSheet {
+		c.invalidateMetadata(spreadsheetID)
+	}
+
+	if len(resp.Replies) > 0 && resp.Replies[0].AddChart != nil && resp.Replies[0].AddChart.Chart != nil {
+		result := chartInfo(resp.Replies[0].AddChart.Chart)
+		result["message"] = "Chart created successfully"
+		return result, nil
+	}
+
+	return map[string]interface{}{
+		"message": "Chart created successfully",
+	}, nil
+}
+
+// findChart returns an existing chart by ID
+func (c *Client) findChart(ctx context.Context, spreadsheetID string, chartID int64) (*sheets.EmbeddedChart, error) {
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.charts").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve charts: %v", err)
+	}
+
+	for _, sheet := range resp.Sheets {
+		for _, chart := range sheet.Charts {
+			if chart.ChartId == chartID {
+				return chart, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("chart %d not found", chartID)
+}
+
+// UpdateChart modifies an existing chart. Only the title can be changed on its
+// own; changing the chart type between basic types keeps the existing data,
+// while any other change requires both the domain and series ranges.
+func (c *Client) UpdateChart(ctx context.Context, spreadsheetID string, chartID int64, opts ChartOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findChart(ctx, spreadsheetID, chartID)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []*sheets.Request
+
+	spec := existing.Spec
+	if spec == nil {
+		spec = &sheets.ChartSpec{}
+	}
+
+	specChanged := false
+	newType := strings.ToUpper(opts.ChartType)
+
+	switch {
+	case opts.DomainRange != "" || len(opts.SeriesRanges) > 0:
+		if newType == "" {
+			newType = chartType(spec)
+		}
+		if opts.Title == "" {
+			opts.Title = spec.Title
+		}
+		opts.ChartType = newType
+		spec, err = c.buildChartSpec(ctx, spreadsheetID, opts)
+		if err != nil {
+			return nil, err
+		}
+		specChanged = true
+	case newType != "" && newType != chartType(spec):
+		if spec.BasicChart == nil || !basicChartTypes[newType] {
+			return nil, fmt.Errorf("changing chart type to %s requires domain_range and series_ranges", newType)
+		}
+		spec.BasicChart.ChartType = newType
+		specChanged = true
+	}
+
+	if opts.Title != "" && opts.Title != spec.Title {
+		spec.Title = opts.Title
+		specChanged = true
+	}
+
+	if specChanged {
+		requests = append(requests, &sheets.Request{
+			UpdateChartSpec: &sheets.UpdateChartSpecRequest{
+				ChartId: chartID,
+				Spec:    spec,
+			},
+		})
+	}
+
+	position, err := c.chartPosition(ctx, spreadsheetID, opts)
+	if err != nil {
+		return nil, err
+	}
+	if position != nil {
+		fields := "overlayPosition"
+		if position.NewSheet {
+			fields = "newSheet"
+		}
+		requests = append(requests, &sheets.Request{
+			UpdateEmbeddedObjectPosition: &sheets.UpdateEmbeddedObjectPositionRequest{
+				ObjectId:    chartID,
+				NewPosition: position,
+				Fields:      fields,
+			},
+		})
+	}
+
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("no chart changes specified")
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: requests,
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to update chart: %v", err)
+	}
+	if opts.NewSheet {
+		c.invalidateMetadata(spreadsheetID)
+	}
+
+	return map[string]interface{}{
+		"chart_id":   chartID,
+		"chart_type": chartType(spec),
+		"title":      spec.Title,
+		"message":    "Chart updated successfully",
+	}, nil
+}
+
+// DeleteChart removes a chart from a spreadsheet
+func (c *Client) DeleteChart(ctx context.Context, spreadsheetID string, chartID int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				DeleteEmbeddedObject: &sheets.DeleteEmbeddedObjectRequest{
+					ObjectId: chartID,
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to delete chart: %v", err)
+	}
+	// Deleting a chart that sits on its own sheet removes the sheet too
+	c.invalidateMetadata(spreadsheetID)
+
+	return map[string]interface{}{
+		"chart_id": chartID,
+		"message":  "Chart deleted successfully",
+	}, nil
+}
@@ -0,0 +1,182 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestCreateChart_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Sales"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{
+					AddChart: &sheets.AddChartResponse{
+						Chart: &sheets.EmbeddedChart{
+							ChartId: 42,
+							Spec:    req.Requests[0].AddChart.Chart.Spec,
+						},
+					},
+				},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.CreateChart(context.Background(), "test-spreadsheet-id", ChartOptions{
+		ChartType:    "column",
+		Title:        "Revenue",
+		DomainRange:  "Sales!A1:A5",
+		SeriesRanges: []string{"Sales!B1:B5", "Sales!C1:C5"},
+		AnchorCell:   "Sales!E2",
+	})
+	if err != nil {
+		t.Fatalf("CreateChart failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["chart_id"] != int64(42) {
+		t.Errorf("Expected chart_id 42, got %v", resultMap["chart_id"])
+	}
+	if resultMap["chart_type"] != "COLUMN" {
+		t.Errorf("Expected chart_type COLUMN, got %v", resultMap["chart_type"])
+	}
+
+	chart := received.Requests[0].AddChart.Chart
+	basic := chart.Spec.BasicChart
+	if basic == nil || len(basic.Series) != 2 {
+		t.Fatalf("Expected basic chart with 2 series, got %+v", chart.Spec)
+	}
+	if basic.Domains[0].Domain.SourceRange.Sources[0].SheetId != 1 {
+		t.Error("Expected domain range to resolve to sheet ID 1")
+	}
+
+	anchor := chart.Position.OverlayPosition.AnchorCell
+	if anchor.SheetId != 1 || anchor.RowIndex != 1 || anchor.ColumnIndex != 4 {
+		t.Errorf("Unexpected anchor cell: %+v", anchor)
+	}
+}
+
+func TestCreateChart_Pie(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	_, err := client.CreateChart(context.Background(), "test-spreadsheet-id", ChartOptions{
+		ChartType:    "pie",
+		DomainRange:  "A1:A5",
+		SeriesRanges: []string{"B1:B5"},
+		NewSheet:     true,
+	})
+	if err != nil {
+		t.Fatalf("CreateChart failed: %v", err)
+	}
+
+	chart := received.Requests[0].AddChart.Chart
+	if chart.Spec.PieChart == nil {
+		t.Error("Expected pie chart spec")
+	}
+	if !chart.Position.NewSheet {
+		t.Error("Expected chart to be placed on a new sheet")
+	}
+}
+
+func TestCreateChart_InvalidType(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	_, err := client.CreateChart(context.Background(), "test-spreadsheet-id", ChartOptions{
+		ChartType:    "radar",
+		DomainRange:  "A1:A5",
+		SeriesRanges: []string{"B1:B5"},
+	})
+	if err == nil {
+		t.Error("Expected error for unsupported chart type")
+	}
+}
+
+func TestUpdateChart_Title(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sheet1")
+	spreadsheet.Sheets[0].Charts = []*sheets.EmbeddedChart{
+		{
+			ChartId: 7,
+			Spec: &sheets.ChartSpec{
+				Title:      "Old",
+				BasicChart: &sheets.BasicChartSpec{ChartType: "LINE"},
+			},
+		},
+	}
+
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, spreadsheet, func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.UpdateChart(context.Background(), "test-spreadsheet-id", 7, ChartOptions{Title: "New", ChartType: "bar"})
+	if err != nil {
+		t.Fatalf("UpdateChart failed: %v", err)
+	}
+
+	spec := received.Requests[0].UpdateChartSpec.Spec
+	if spec.Title != "New" || spec.BasicChart.ChartType != "BAR" {
+		t.Errorf("Unexpected updated spec: title=%q type=%q", spec.Title, spec.BasicChart.ChartType)
+	}
+
+	if result.(map[string]interface{})["chart_type"] != "BAR" {
+		t.Errorf("Expected chart_type BAR, got %v", result.(map[string]interface{})["chart_type"])
+	}
+}
+
+func TestUpdateChart_NotFound(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.UpdateChart(context.Background(), "test-spreadsheet-id", 99, ChartOptions{Title: "x"}); err == nil {
+		t.Error("Expected error for missing chart")
+	}
+}
+
+func TestDeleteChart_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, nil, func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.DeleteChart(context.Background(), "test-spreadsheet-id", 42); err != nil {
+		t.Fatalf("DeleteChart failed: %v", err)
+	}
+
+	if received.Requests[0].DeleteEmbeddedObject.ObjectId != 42 {
+		t.Errorf("Expected object ID 42, got %d", received.Requests[0].DeleteEmbeddedObject.ObjectId)
+	}
+}
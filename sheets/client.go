@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/conallob/mcp-google-sheets/dryrun"
 	"google.golang.org/api/sheets/v4"
 )
 
 // Client wraps the Google Sheets API service
 type Client struct {
 	service *sheets.Service
+	cache   metadataCache
 }
 
 // NewClient creates a new Sheets client
@@ -20,10 +22,28 @@ func NewClient(service *sheets.Service) *Client {
 	}
 }
 
+// checkService returns an error if the client has no Sheets service to call
+func (c *Client) checkService() error {
+	if c.service == nil {
+		return fmt.Errorf("sheets service is not initialized")
+	}
+	return nil
+}
+
 // ReadSheet reads data from a spreadsheet range
 func (c *Client) ReadSheet(ctx context.Context, spreadsheetID, readRange string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
 	if readRange == "" {
 		readRange = "Sheet1"
+	} else {
+		resolved, err := c.resolveA1(ctx, spreadsheetID, readRange)
+		if err != nil {
+			return nil, err
+		}
+		readRange = resolved
 	}
 
 	resp, err := c.service.Spreadsheets.Values.Get(spreadsheetID, readRange).Context(ctx).Do()
@@ -59,6 +79,19 @@ func (c *Client) ReadSheet(ctx context.Context, spreadsheetID, readRange string)
 
 // WriteSheet writes data to a spreadsheet range
 func (c *Client) WriteSheet(ctx context.Context, spreadsheetID, writeRange string, values [][]string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewValues(ctx, spreadsheetID, writeRange, values)
+	}
+
+	writeRange, err := c.resolveA1(ctx, spreadsheetID, writeRange)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert [][]string to [][]interface{} for the API
 	interfaceValues := make([][]interface{}, len(values))
 	for i, row := range values {
@@ -94,6 +127,19 @@ func (c *Client) WriteSheet(ctx context.Context, spreadsheetID, writeRange strin
 
 // AppendSheet appends data to a spreadsheet
 func (c *Client) AppendSheet(ctx context.Context, spreadsheetID, appendRange string, values [][]string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewAppend(ctx, spreadsheetID, appendRange, values)
+	}
+
+	appendRange, err := c.resolveA1(ctx, spreadsheetID, appendRange)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert [][]string to [][]interface{} for the API
 	interfaceValues := make([][]interface{}, len(values))
 	for i, row := range values {
@@ -128,67 +174,53 @@ func (c *Client) AppendSheet(ctx context.Context, spreadsheetID, appendRange str
 	}, nil
 }
 
-// CreateSpreadsheet creates a new spreadsheet
+// CreateSpreadsheet creates a new spreadsheet with the named sheets
 func (c *Client) CreateSpreadsheet(ctx context.Context, title string, sheetNames []string) (interface{}, error) {
-	spreadsheet := &sheets.Spreadsheet{
-		Properties: &sheets.SpreadsheetProperties{
-			Title: title,
-		},
-	}
-
-	// Add sheets if specified
-	if len(sheetNames) > 0 {
-		spreadsheet.Sheets = make([]*sheets.Sheet, len(sheetNames))
-		for i, name := range sheetNames {
-			spreadsheet.Sheets[i] = &sheets.Sheet{
-				Properties: &sheets.SheetProperties{
-					Title: name,
-				},
-			}
-		}
-	}
-
-	resp, err := c.service.Spreadsheets.Create(spreadsheet).Context(ctx).Do()
-	if err != nil {
-		return nil, fmt.Errorf("unable to create spreadsheet: %v", err)
+	specs := make([]SheetSpec, len(sheetNames))
+	for i, name := range sheetNames {
+		specs[i] = SheetSpec{Title: name}
 	}
-
-	sheetTitles := make([]string, len(resp.Sheets))
-	for i, sheet := range resp.Sheets {
-		sheetTitles[i] = sheet.Properties.Title
-	}
-
-	return map[string]interface{}{
-		"spreadsheet_id":  resp.SpreadsheetId,
-		"spreadsheet_url": resp.SpreadsheetUrl,
-		"title":           resp.Properties.Title,
-		"sheets":          sheetTitles,
-		"message":         "Spreadsheet created successfully",
-	}, nil
+	return c.CreateSpreadsheetWithOptions(ctx, title, SpreadsheetOptions{Sheets: specs})
 }
 
 // GetSpreadsheetInfo retrieves metadata about a spreadsheet
 func (c *Client) GetSpreadsheetInfo(ctx context.Context, spreadsheetID string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
 	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Context(ctx).Do()
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve spreadsheet info: %v", err)
 	}
 
+	titles := sheetTitles(resp.Sheets)
 	sheetInfo := make([]map[string]interface{}, len(resp.Sheets))
 	for i, sheet := range resp.Sheets {
-		props := sheet.Properties
-		sheetInfo[i] = map[string]interface{}{
-			"sheet_id":    props.SheetId,
-			"title":       props.Title,
-			"index":       props.Index,
-			"sheet_type":  props.SheetType,
-			"row_count":   props.GridProperties.RowCount,
-			"col_count":   props.GridProperties.ColumnCount,
-			"frozen_rows": props.GridProperties.FrozenRowCount,
-			"frozen_cols": props.GridProperties.FrozenColumnCount,
+		sheetInfo[i] = sheetPropertiesInfo(sheet.Properties)
+
+		if len(sheet.Charts) > 0 {
+			charts := make([]map[string]interface{}, len(sheet.Charts))
+			for j, chart := range sheet.Charts {
+				charts[j] = chartInfo(chart)
+			}
+			sheetInfo[i]["charts"] = charts
+		}
+
+		if len(sheet.FilterViews) > 0 {
+			views := make([]map[string]interface{}, len(sheet.FilterViews))
+			for j, fv := range sheet.FilterViews {
+				views[j] = filterViewInfo(fv, titles)
+			}
+			sheetInfo[i]["filter_views"] = views
 		}
 	}
 
+	namedRanges := make([]map[string]interface{}, len(resp.NamedRanges))
+	for i, nr := range resp.NamedRanges {
+		namedRanges[i] = namedRangeInfo(nr, titles)
+	}
+
 	return map[string]interface{}{
 		"spreadsheet_id":  resp.SpreadsheetId,
 		"title":           resp.Properties.Title,
@@ -196,11 +228,16 @@ func (c *Client) GetSpreadsheetInfo(ctx context.Context, spreadsheetID string) (
 		"time_zone":       resp.Properties.TimeZone,
 		"spreadsheet_url": resp.SpreadsheetUrl,
 		"sheets":          sheetInfo,
+		"named_ranges":    namedRanges,
 	}, nil
 }
 
 // AddSheet adds a new sheet to an existing spreadsheet
 func (c *Client) AddSheet(ctx context.Context, spreadsheetID, sheetName string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
 	requests := []*sheets.Request{
 		{
 			AddSheet: &sheets.AddSheetRequest{
@@ -215,10 +252,15 @@ func (c *Client) AddSheet(ctx context.Context, spreadsheetID, sheetName string)
 		Requests: requests,
 	}
 
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
 	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
 	if err != nil {
 		return nil, fmt.Errorf("unable to add sheet: %v", err)
 	}
+	c.invalidateMetadata(spreadsheetID)
 
 	if len(resp.Replies) > 0 && resp.Replies[0].AddSheet != nil {
 		props := resp.Replies[0].AddSheet.Properties
@@ -237,6 +279,19 @@ func (c *Client) AddSheet(ctx context.Context, spreadsheetID, sheetName string)
 
 // ClearSheet clears data in a specified range
 func (c *Client) ClearSheet(ctx context.Context, spreadsheetID, clearRange string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewClear(ctx, spreadsheetID, clearRange)
+	}
+
+	clearRange, err := c.resolveA1(ctx, spreadsheetID, clearRange)
+	if err != nil {
+		return nil, err
+	}
+
 	clearRequest := &sheets.ClearValuesRequest{}
 
 	resp, err := c.service.Spreadsheets.Values.Clear(spreadsheetID, clearRange, clearRequest).Context(ctx).Do()
@@ -252,6 +307,10 @@ func (c *Client) ClearSheet(ctx context.Context, spreadsheetID, clearRange strin
 
 // BatchUpdate performs multiple updates on a spreadsheet
 func (c *Client) BatchUpdate(ctx context.Context, spreadsheetID string, requestsData []map[string]interface{}) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
 	// Convert the generic map to JSON and back to sheets.Request
 	requestsJSON, err := json.Marshal(map[string]interface{}{"requests": requestsData})
 	if err != nil {
@@ -263,10 +322,15 @@ func (c *Client) BatchUpdate(ctx context.Context, spreadsheetID string, requests
 		return nil, fmt.Errorf("unable to unmarshal requests: %v", err)
 	}
 
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
 	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, &batchUpdateRequest).Context(ctx).Do()
 	if err != nil {
 		return nil, fmt.Errorf("unable to batch update: %v", err)
 	}
+	c.invalidateMetadata(spreadsheetID)
 
 	return map[string]interface{}{
 		"spreadsheet_id": resp.SpreadsheetId,
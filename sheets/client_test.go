@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 
 	"google.golang.org/api/option"
@@ -14,7 +15,7 @@ import (
 )
 
 // mockSheetsService creates a mock Google Sheets service for testing
-func mockSheetsService(t *testing.T, handler http.HandlerFunc) (*sheets.Service, *httptest.Server) {
+func mockSheetsService(t testing.TB, handler http.HandlerFunc) (*sheets.Service, *httptest.Server) {
 	server := httptest.NewServer(handler)
 	service, err := sheets.NewService(context.Background(), option.WithHTTPClient(server.Client()), option.WithEndpoint(server.URL))
 	if err != nil {
@@ -25,6 +26,63 @@ func mockSheetsService(t *testing.T, handler http.HandlerFunc) (*sheets.Service,
 	return service, server
 }
 
+// mockSpreadsheetHandler serves spreadsheet metadata on GET requests and
+// passes batchUpdate requests to onBatchUpdate, which returns the reply
+func mockSpreadsheetHandler(t testing.TB, spreadsheet *sheets.Spreadsheet, onBatchUpdate func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(spreadsheet)
+			return
+		}
+
+		if strings.HasSuffix(r.URL.Path, ":batchUpdate") && onBatchUpdate != nil {
+			var req sheets.BatchUpdateSpreadsheetRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("Failed to decode batchUpdate request: %v", err)
+			}
+			json.NewEncoder(w).Encode(onBatchUpdate(&req))
+			return
+		}
+
+		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+// withHeaderRow wraps a handler so that value reads return a single header row
+func withHeaderRow(next http.HandlerFunc, headers ...interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/values/") {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&sheets.ValueRange{Values: [][]interface{}{headers}})
+			return
+		}
+		next(w, r)
+	}
+}
+
+// testSpreadsheet returns spreadsheet metadata with the given sheet titles,
+// numbered from sheet ID 0
+func testSpreadsheet(titles ...string) *sheets.Spreadsheet {
+	spreadsheet := &sheets.Spreadsheet{SpreadsheetId: "test-spreadsheet-id"}
+	for i, title := range titles {
+		spreadsheet.Sheets = append(spreadsheet.Sheets, &sheets.Sheet{
+			Properties: &sheets.SheetProperties{
+				SheetId: int64(i),
+				Title:   title,
+				Index:   int64(i),
+				GridProperties: &sheets.GridProperties{
+					RowCount:    1000,
+					ColumnCount: 26,
+				},
+			},
+		})
+	}
+	return spreadsheet
+}
+
 func TestNewClient(t *testing.T) {
 	service, server := mockSheetsService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	defer server.Close()
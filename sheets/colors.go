@@ -0,0 +1,64 @@
+package sheets
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// parseHexColor converts a "#RRGGBB" or "RRGGBB" string into a sheets.Color
+func parseHexColor(hex string) (*sheets.Color, error) {
+	h := strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(h) == 3 {
+		h = string([]byte{h[0], h[0], h[1], h[1], h[2], h[2]})
+	}
+	if len(h) != 6 {
+		return nil, fmt.Errorf("invalid color %q (expected #RRGGBB)", hex)
+	}
+
+	v, err := strconv.ParseUint(h, 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %q (expected #RRGGBB)", hex)
+	}
+
+	return &sheets.Color{
+		Red:             float64(v>>16&0xff) / 255,
+		Green:           float64(v>>8&0xff) / 255,
+		Blue:            float64(v&0xff) / 255,
+		ForceSendFields: []string{"Red", "Green", "Blue"},
+	}, nil
+}
+
+// colorStyle converts a hex string into a sheets.ColorStyle
+func colorStyle(hex string) (*sheets.ColorStyle, error) {
+	color, err := parseHexColor(hex)
+	if err != nil {
+		return nil, err
+	}
+	return &sheets.ColorStyle{RgbColor: color}, nil
+}
+
+// hexColor renders a sheets.Color as "#RRGGBB"
+func hexColor(color *sheets.Color) string {
+	if color == nil {
+		return ""
+	}
+	channel := func(f float64) int { return int(math.Round(f * 255)) }
+	return fmt.Sprintf("#%02X%02X%02X", channel(color.Red), channel(color.Green), channel(color.Blue))
+}
+
+// hexColorStyle renders a sheets.ColorStyle as "#RRGGBB", or the theme color
+// name when the style refers to the spreadsheet theme
+func hexColorStyle(style *sheets.ColorStyle) string {
+	switch {
+	case style == nil:
+		return ""
+	case style.ThemeColor != "":
+		return style.ThemeColor
+	default:
+		return hexColor(style.RgbColor)
+	}
+}
@@ -0,0 +1,265 @@
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// HeaderStyle formats the first row of a sheet's initial values. The header
+// is bold unless Bold is explicitly false; empty colors are left unchanged.
+type HeaderStyle struct {
+	Bold            *bool  `json:"bold,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	TextColor       string `json:"text_color,omitempty"`
+}
+
+// SheetSpec describes a sheet to create. Zero sizes keep the API defaults
+// (1000 rows by 26 columns), grown as needed to fit Values.
+type SheetSpec struct {
+	Title       string       `json:"title"`
+	RowCount    int64        `json:"row_count,omitempty"`
+	ColumnCount int64        `json:"column_count,omitempty"`
+	FrozenRows  int64        `json:"frozen_rows,omitempty"`
+	TabColor    string       `json:"tab_color,omitempty"`
+	Values      [][]string   `json:"values,omitempty"`
+	HeaderStyle *HeaderStyle `json:"header_style,omitempty"`
+}
+
+// UnmarshalJSON accepts either a full sheet object or just its title, so
+// plain sheet name lists keep working
+func (s *SheetSpec) UnmarshalJSON(data []byte) error {
+	var title string
+	if err := json.Unmarshal(data, &title); err == nil {
+		*s = SheetSpec{Title: title}
+		return nil
+	}
+
+	type sheetSpec SheetSpec
+	var spec sheetSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return err
+	}
+	*s = SheetSpec(spec)
+	return nil
+}
+
+// SpreadsheetOptions configures a new spreadsheet. Empty Locale and TimeZone
+// keep the account defaults; no Sheets creates one default sheet.
+type SpreadsheetOptions struct {
+	Locale   string
+	TimeZone string
+	Sheets   []SheetSpec
+}
+
+// defaultRowCount and defaultColumnCount are the grid size of a new sheet
+const (
+	defaultRowCount    = 1000
+	defaultColumnCount = 26
+)
+
+// headerFormat builds the cell format and field mask for a header row
+func headerFormat(style *HeaderStyle) (*sheets.CellFormat, error) {
+	format := &sheets.CellFormat{TextFormat: &sheets.TextFormat{
+		Bold:            style.Bold == nil || *style.Bold,
+		ForceSendFields: []string{"Bold"},
+	}}
+	if style.TextColor != "" {
+		color, err := colorStyle(style.TextColor)
+		if err != nil {
+			return nil, err
+		}
+		format.TextFormat.ForegroundColorStyle = color
+	}
+	if style.BackgroundColor != "" {
+		color, err := colorStyle(style.BackgroundColor)
+		if err != nil {
+			return nil, err
+		}
+		format.BackgroundColorStyle = color
+	}
+	return format, nil
+}
+
+// buildSheet converts a SheetSpec into the sheet sent with the create request.
+// Header formatting is included here; values are written afterwards so they
+// are parsed exactly as write_sheet parses them.
+func buildSheet(spec SheetSpec) (*sheets.Sheet, error) {
+	width := int64(0)
+	for _, row := range spec.Values {
+		width = max(width, int64(len(row)))
+	}
+	height := int64(len(spec.Values))
+
+	if spec.RowCount < 0 || spec.ColumnCount < 0 || spec.FrozenRows < 0 {
+		return nil, fmt.Errorf("sheet %q: sizes must not be negative", spec.Title)
+	}
+	if spec.RowCount > 0 && spec.RowCount < height {
+		return nil, fmt.Errorf("sheet %q: row_count %d is smaller than the %d rows of values", spec.Title, spec.RowCount, height)
+	}
+	if spec.ColumnCount > 0 && spec.ColumnCount < width {
+		return nil, fmt.Errorf("sheet %q: column_count %d is smaller than the %d columns of values", spec.Title, spec.ColumnCount, width)
+	}
+
+	grid := &sheets.GridProperties{
+		RowCount:       spec.RowCount,
+		ColumnCount:    spec.ColumnCount,
+		FrozenRowCount: spec.FrozenRows,
+	}
+	if grid.RowCount == 0 && height > defaultRowCount {
+		grid.RowCount = height
+	}
+	if grid.ColumnCount == 0 && width > defaultColumnCount {
+		grid.ColumnCount = width
+	}
+	rows := grid.RowCount
+	if rows == 0 {
+		rows = defaultRowCount
+	}
+	if spec.FrozenRows >= rows {
+		return nil, fmt.Errorf("sheet %q: frozen_rows must be less than the row count", spec.Title)
+	}
+
+	sheet := &sheets.Sheet{
+		Properties: &sheets.SheetProperties{
+			Title:          spec.Title,
+			GridProperties: grid,
+		},
+	}
+
+	if spec.TabColor != "" {
+		color, err := colorStyle(spec.TabColor)
+		if err != nil {
+			return nil, err
+		}
+		sheet.Properties.TabColorStyle = color
+	}
+
+	if spec.HeaderStyle != nil && height > 0 {
+		format, err := headerFormat(spec.HeaderStyle)
+		if err != nil {
+			return nil, err
+		}
+		cells := make([]*sheets.CellData, len(spec.Values[0]))
+		for i := range cells {
+			cells[i] = &sheets.CellData{UserEnteredFormat: format}
+		}
+		sheet.Data = []*sheets.GridData{{RowData: []*sheets.RowData{{Values: cells}}}}
+	}
+
+	return sheet, nil
+}
+
+// CreateSpreadsheetWithOptions creates a ready-to-use spreadsheet: locale,
+// time zone, sheet sizes, frozen rows, tab colors and header formatting are
+// set in the create request, and any initial values are written in a single
+// follow-up batch, as user-entered input.
+func (c *Client) CreateSpreadsheetWithOptions(ctx context.Context, title string, opts SpreadsheetOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	spreadsheet := &sheets.Spreadsheet{
+		Properties: &sheets.SpreadsheetProperties{
+			Title:    title,
+			Locale:   opts.Locale,
+			TimeZone: opts.TimeZone,
+		},
+	}
+
+	for _, spec := range opts.Sheets {
+		sheet, err := buildSheet(spec)
+		if err != nil {
+			return nil, err
+		}
+		spreadsheet.Sheets = append(spreadsheet.Sheets, sheet)
+	}
+
+	if dryrun.Enabled(ctx) {
+		sheetInfo := make([]map[string]interface{}, len(spreadsheet.Sheets))
+		for i, sheet := range spreadsheet.Sheets {
+			grid := sheet.Properties.GridProperties
+			rows, columns := grid.RowCount, grid.ColumnCount
+			if rows == 0 {
+				rows = defaultRowCount
+			}
+			if columns == 0 {
+				columns = defaultColumnCount
+			}
+			cells := 0
+			for _, row := range opts.Sheets[i].Values {
+				cells += len(row)
+			}
+			sheetInfo[i] = map[string]interface{}{
+				"title":        sheet.Properties.Title,
+				"row_count":    rows,
+				"column_count": columns,
+				"frozen_rows":  grid.FrozenRowCount,
+				"cells":        cells,
+			}
+		}
+		return dryRunResult("", map[string]interface{}{
+			"title":     title,
+			"locale":    opts.Locale,
+			"time_zone": opts.TimeZone,
+			"sheets":    sheetInfo,
+		}), nil
+	}
+
+	resp, err := c.service.Spreadsheets.Create(spreadsheet).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create spreadsheet: %v", err)
+	}
+
+	sheetTitles := make([]string, len(resp.Sheets))
+	for i, sheet := range resp.Sheets {
+		sheetTitles[i] = sheet.Properties.Title
+	}
+
+	result := map[string]interface{}{
+		"spreadsheet_id":  resp.SpreadsheetId,
+		"spreadsheet_url": resp.SpreadsheetUrl,
+		"title":           resp.Properties.Title,
+		"sheets":          sheetTitles,
+		"message":         "Spreadsheet created successfully",
+	}
+	if opts.Locale != "" || opts.TimeZone != "" {
+		result["locale"] = resp.Properties.Locale
+		result["time_zone"] = resp.Properties.TimeZone
+	}
+
+	var data []*sheets.ValueRange
+	for i, spec := range opts.Sheets {
+		if len(spec.Values) == 0 || i >= len(sheetTitles) {
+			continue
+		}
+		rows := make([][]interface{}, len(spec.Values))
+		for r, row := range spec.Values {
+			rows[r] = make([]interface{}, len(row))
+			for col, cell := range row {
+				rows[r][col] = cell
+			}
+		}
+		data = append(data, &sheets.ValueRange{
+			Range:  quoteSheetName(sheetTitles[i]) + "!A1",
+			Values: rows,
+		})
+	}
+	if len(data) == 0 {
+		return result, nil
+	}
+
+	valuesResp, err := c.service.Spreadsheets.Values.BatchUpdate(resp.SpreadsheetId, &sheets.BatchUpdateValuesRequest{
+		ValueInputOption: "USER_ENTERED",
+		Data:             data,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("spreadsheet %s was created but writing initial values failed: %v", resp.SpreadsheetId, err)
+	}
+	result["updated_cells"] = valuesResp.TotalUpdatedCells
+
+	return result, nil
+}
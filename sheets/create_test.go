@@ -0,0 +1,123 @@
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestSheetSpec_UnmarshalJSON(t *testing.T) {
+	var specs []SheetSpec
+	data := `["Summary", {"title": "Data", "frozen_rows": 1, "values": [["Name", "Age"]], "header_style": {"background_color": "#DDEEFF"}}]`
+	if err := json.Unmarshal([]byte(data), &specs); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if len(specs) != 2 || specs[0].Title != "Summary" || specs[1].Title != "Data" {
+		t.Fatalf("Unexpected specs: %+v", specs)
+	}
+	if specs[1].FrozenRows != 1 || specs[1].HeaderStyle.BackgroundColor != "#DDEEFF" || specs[1].Values[0][1] != "Age" {
+		t.Errorf("Unexpected sheet spec: %+v", specs[1])
+	}
+}
+
+func TestCreateSpreadsheetWithOptions_Success(t *testing.T) {
+	var created sheets.Spreadsheet
+	var values sheets.BatchUpdateValuesRequest
+	calls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/values:batchUpdate") {
+			json.NewDecoder(r.Body).Decode(&values)
+			json.NewEncoder(w).Encode(&sheets.BatchUpdateValuesResponse{TotalUpdatedCells: 4})
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&created)
+		created.SpreadsheetId = "new-id"
+		json.NewEncoder(w).Encode(&created)
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	bold := false
+	result, err := client.CreateSpreadsheetWithOptions(context.Background(), "Report", SpreadsheetOptions{
+		Locale:   "en_GB",
+		TimeZone: "Europe/London",
+		Sheets: []SheetSpec{
+			{
+				Title:       "Q1 Data",
+				ColumnCount: 5,
+				FrozenRows:  1,
+				TabColor:    "#FF0000",
+				Values:      [][]string{{"Month", "Total"}, {"Jan", "=SUM(1,2)"}},
+				HeaderStyle: &HeaderStyle{Bold: &bold, TextColor: "#FFFFFF"},
+			},
+			{Title: "Notes"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateSpreadsheetWithOptions failed: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 API calls, got %d", calls)
+	}
+	if created.Properties.Locale != "en_GB" || created.Properties.TimeZone != "Europe/London" {
+		t.Errorf("Unexpected spreadsheet properties: %+v", created.Properties)
+	}
+
+	data := created.Sheets[0]
+	grid := data.Properties.GridProperties
+	if grid.ColumnCount != 5 || grid.FrozenRowCount != 1 || grid.RowCount != 0 {
+		t.Errorf("Unexpected grid properties: %+v", grid)
+	}
+	if data.Properties.TabColorStyle == nil || data.Properties.TabColorStyle.RgbColor.Red != 1 {
+		t.Errorf("Expected red tab color, got %+v", data.Properties.TabColorStyle)
+	}
+	header := data.Data[0].RowData[0].Values
+	if len(header) != 2 || header[0].UserEnteredFormat.TextFormat.Bold || header[0].UserEnteredFormat.TextFormat.ForegroundColorStyle == nil {
+		t.Errorf("Unexpected header format: %+v", header[0].UserEnteredFormat)
+	}
+	if created.Sheets[1].Data != nil {
+		t.Error("Expected no grid data for a sheet without values")
+	}
+
+	if values.ValueInputOption != "USER_ENTERED" || len(values.Data) != 1 || values.Data[0].Range != "'Q1 Data'!A1" {
+		t.Errorf("Unexpected values request: %+v", values)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["updated_cells"] != int64(4) || resultMap["locale"] != "en_GB" {
+		t.Errorf("Unexpected result: %v", resultMap)
+	}
+}
+
+func TestCreateSpreadsheetWithOptions_Validation(t *testing.T) {
+	service, server := mockSheetsService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request expected")
+	}))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	invalid := []SheetSpec{
+		{Title: "A", RowCount: 1, Values: [][]string{{"a"}, {"b"}}},
+		{Title: "B", ColumnCount: 1, Values: [][]string{{"a", "b"}}},
+		{Title: "C", RowCount: 5, FrozenRows: 5},
+		{Title: "D", TabColor: "red"},
+		{Title: "E", Values: [][]string{{"a"}}, HeaderStyle: &HeaderStyle{BackgroundColor: "nope"}},
+	}
+	for _, spec := range invalid {
+		if _, err := client.CreateSpreadsheetWithOptions(context.Background(), "Bad", SpreadsheetOptions{Sheets: []SheetSpec{spec}}); err == nil {
+			t.Errorf("Expected error for %+v", spec)
+		}
+	}
+}
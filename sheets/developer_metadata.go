@@ -0,0 +1,281 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// MetadataLocation says where developer metadata is attached: a run of rows
+// or columns when Dimension is set, a sheet when only Sheet is set, and the
+// spreadsheet itself otherwise
+type MetadataLocation struct {
+	Sheet     string
+	Dimension string
+	Start     string
+	End       string
+}
+
+// MetadataLookup selects developer metadata. Unset fields match anything.
+type MetadataLookup struct {
+	ID           int64
+	Key          string
+	Value        string
+	LocationType string
+}
+
+// metadataLocation resolves a MetadataLocation into a sheets.DeveloperMetadataLocation
+func (c *Client) metadataLocation(ctx context.Context, spreadsheetID string, loc MetadataLocation) (*sheets.DeveloperMetadataLocation, error) {
+	switch {
+	case loc.Dimension != "":
+		dr, err := c.dimensionRange(ctx, spreadsheetID, DimensionSpan{
+			Sheet:     loc.Sheet,
+			Dimension: loc.Dimension,
+			Start:     loc.Start,
+			End:       loc.End,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &sheets.DeveloperMetadataLocation{DimensionRange: dr}, nil
+	case loc.Sheet != "":
+		sheet, err := c.findSheet(ctx, spreadsheetID, loc.Sheet)
+		if err != nil {
+			return nil, err
+		}
+		return &sheets.DeveloperMetadataLocation{SheetId: sheet.SheetId, ForceSendFields: []string{"SheetId"}}, nil
+	default:
+		return &sheets.DeveloperMetadataLocation{Spreadsheet: true}, nil
+	}
+}
+
+// dataFilter converts a lookup into a sheets.DataFilter, refusing an empty
+// lookup that would match every piece of metadata
+func (l MetadataLookup) dataFilter() (*sheets.DataFilter, error) {
+	if l.ID == 0 && l.Key == "" && l.Value == "" {
+		return nil, fmt.Errorf("metadata_id, key or value is required")
+	}
+
+	lookup := &sheets.DeveloperMetadataLookup{
+		MetadataId:    l.ID,
+		MetadataKey:   l.Key,
+		MetadataValue: l.Value,
+		LocationType:  strings.ToUpper(l.LocationType),
+	}
+	return &sheets.DataFilter{DeveloperMetadataLookup: lookup}, nil
+}
+
+// developerMetadataInfo summarises developer metadata for tool output
+func developerMetadataInfo(dm *sheets.DeveloperMetadata, titles map[int64]string) map[string]interface{} {
+	info := map[string]interface{}{
+		"metadata_id": dm.MetadataId,
+		"key":         dm.MetadataKey,
+		"value":       dm.MetadataValue,
+		"visibility":  dm.Visibility,
+	}
+
+	if loc := dm.Location; loc != nil {
+		location := map[string]interface{}{"type": loc.LocationType}
+		switch {
+		case loc.DimensionRange != nil:
+			dr := loc.DimensionRange
+			location["sheet"] = titles[dr.SheetId]
+			location["dimension"] = dr.Dimension
+			location["start"] = dimensionLabel(dr.Dimension, dr.StartIndex)
+			location["end"] = dimensionLabel(dr.Dimension, dr.EndIndex-1)
+		case loc.LocationType == "SHEET":
+			location["sheet"] = titles[loc.SheetId]
+		}
+		info["location"] = location
+	}
+
+	return info
+}
+
+// CreateDeveloperMetadata attaches a key/value pair to the spreadsheet, a
+// sheet, or a run of rows or columns. Metadata on rows and columns moves with
+// them through inserts, deletes and sorts. visibility is DOCUMENT (default)
+// or PROJECT.
+func (c *Client) CreateDeveloperMetadata(ctx context.Context, spreadsheetID, key, value, visibility string, loc MetadataLocation) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		return nil, fmt.Errorf("key is required")
+	}
+
+	visibility = strings.ToUpper(visibility)
+	if visibility == "" {
+		visibility = "DOCUMENT"
+	}
+	if visibility != "DOCUMENT" && visibility != "PROJECT" {
+		return nil, fmt.Errorf("invalid visibility %q (expected DOCUMENT or PROJECT)", visibility)
+	}
+
+	location, err := c.metadataLocation(ctx, spreadsheetID, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				CreateDeveloperMetadata: &sheets.CreateDeveloperMetadataRequest{
+					DeveloperMetadata: &sheets.DeveloperMetadata{
+						MetadataKey:   key,
+						MetadataValue: value,
+						Visibility:    visibility,
+						Location:      location,
+					},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create developer metadata: %v", err)
+	}
+
+	result := map[string]interface{}{
+		"key":     key,
+		"value":   value,
+		"message": "Developer metadata created successfully",
+	}
+	if len(resp.Replies) > 0 && resp.Replies[0].CreateDeveloperMetadata != nil && resp.Replies[0].CreateDeveloperMetadata.DeveloperMetadata != nil {
+		result["metadata_id"] = resp.Replies[0].CreateDeveloperMetadata.DeveloperMetadata.MetadataId
+	}
+
+	return result, nil
+}
+
+// SearchDeveloperMetadata finds developer metadata matching a lookup
+func (c *Client) SearchDeveloperMetadata(ctx context.Context, spreadsheetID string, lookup MetadataLookup) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	filter, err := lookup.dataFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.service.Spreadsheets.DeveloperMetadata.Search(spreadsheetID, &sheets.SearchDeveloperMetadataRequest{
+		DataFilters: []*sheets.DataFilter{filter},
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to search developer metadata: %v", err)
+	}
+
+	meta, err := c.metadata(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+	titles := make(map[int64]string, len(meta.sheets))
+	for _, p := range meta.sheets {
+		titles[p.SheetId] = p.Title
+	}
+
+	matches := []map[string]interface{}{}
+	for _, m := range resp.MatchedDeveloperMetadata {
+		if m.DeveloperMetadata != nil {
+			matches = append(matches, developerMetadataInfo(m.DeveloperMetadata, titles))
+		}
+	}
+
+	return map[string]interface{}{
+		"developer_metadata": matches,
+		"count":              len(matches),
+	}, nil
+}
+
+// DeleteDeveloperMetadata deletes all developer metadata matching a lookup
+func (c *Client) DeleteDeveloperMetadata(ctx context.Context, spreadsheetID string, lookup MetadataLookup) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	filter, err := lookup.dataFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				DeleteDeveloperMetadata: &sheets.DeleteDeveloperMetadataRequest{DataFilter: filter},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to delete developer metadata: %v", err)
+	}
+
+	deleted := 0
+	if len(resp.Replies) > 0 && resp.Replies[0].DeleteDeveloperMetadata != nil {
+		deleted = len(resp.Replies[0].DeleteDeveloperMetadata.DeletedDeveloperMetadata)
+	}
+
+	return map[string]interface{}{
+		"deleted": deleted,
+		"message": "Developer metadata deleted successfully",
+	}, nil
+}
+
+// ReadByMetadata reads the values of the rows, columns or sheets tagged with
+// developer metadata matching a lookup
+func (c *Client) ReadByMetadata(ctx context.Context, spreadsheetID string, lookup MetadataLookup) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	filter, err := lookup.dataFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.service.Spreadsheets.Values.BatchGetByDataFilter(spreadsheetID, &sheets.BatchGetValuesByDataFilterRequest{
+		DataFilters:    []*sheets.DataFilter{filter},
+		MajorDimension: "ROWS",
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve data by metadata: %v", err)
+	}
+
+	ranges := []map[string]interface{}{}
+	for _, matched := range resp.ValueRanges {
+		if matched.ValueRange == nil {
+			continue
+		}
+		values := make([][]string, len(matched.ValueRange.Values))
+		for i, row := range matched.ValueRange.Values {
+			values[i] = make([]string, len(row))
+			for j, cell := range row {
+				values[i][j] = fmt.Sprintf("%v", cell)
+			}
+		}
+		ranges = append(ranges, map[string]interface{}{
+			"range":  matched.ValueRange.Range,
+			"values": values,
+		})
+	}
+
+	return map[string]interface{}{
+		"value_ranges": ranges,
+		"count":        len(ranges),
+	}, nil
+}
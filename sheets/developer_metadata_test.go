@@ -0,0 +1,168 @@
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestCreateDeveloperMetadata_RowLocation(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Records"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{CreateDeveloperMetadata: &sheets.CreateDeveloperMetadataResponse{DeveloperMetadata: &sheets.DeveloperMetadata{MetadataId: 77}}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.CreateDeveloperMetadata(context.Background(), "test-spreadsheet-id", "record_id", "R-1001", "", MetadataLocation{
+		Sheet:     "Records",
+		Dimension: "rows",
+		Start:     "5",
+	})
+	if err != nil {
+		t.Fatalf("CreateDeveloperMetadata failed: %v", err)
+	}
+
+	dm := received.Requests[0].CreateDeveloperMetadata.DeveloperMetadata
+	if dm.Visibility != "DOCUMENT" || dm.MetadataKey != "record_id" {
+		t.Errorf("Unexpected metadata: %+v", dm)
+	}
+	if dr := dm.Location.DimensionRange; dr == nil || dr.SheetId != 1 || dr.StartIndex != 4 || dr.EndIndex != 5 {
+		t.Errorf("Unexpected location: %+v", dm.Location)
+	}
+	if result.(map[string]interface{})["metadata_id"] != int64(77) {
+		t.Errorf("Expected metadata_id 77, got %v", result.(map[string]interface{})["metadata_id"])
+	}
+
+	if _, err := client.CreateDeveloperMetadata(context.Background(), "test-spreadsheet-id", "k", "v", "PUBLIC", MetadataLocation{}); err == nil {
+		t.Error("Expected error for invalid visibility")
+	}
+}
+
+func TestSearchDeveloperMetadata_Info(t *testing.T) {
+	metadata := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Records"), nil)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/developerMetadata:search") {
+			var req sheets.SearchDeveloperMetadataRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.DataFilters[0].DeveloperMetadataLookup.MetadataKey != "record_id" {
+				t.Errorf("Unexpected lookup: %+v", req.DataFilters[0].DeveloperMetadataLookup)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&sheets.SearchDeveloperMetadataResponse{
+				MatchedDeveloperMetadata: []*sheets.MatchedDeveloperMetadata{
+					{DeveloperMetadata: &sheets.DeveloperMetadata{
+						MetadataId:    77,
+						MetadataKey:   "record_id",
+						MetadataValue: "R-1001",
+						Location: &sheets.DeveloperMetadataLocation{
+							LocationType:   "ROW",
+							DimensionRange: &sheets.DimensionRange{SheetId: 1, Dimension: "ROWS", StartIndex: 4, EndIndex: 5},
+						},
+					}},
+				},
+			})
+			return
+		}
+		metadata(w, r)
+	}
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.SearchDeveloperMetadata(context.Background(), "test-spreadsheet-id", MetadataLookup{Key: "record_id"})
+	if err != nil {
+		t.Fatalf("SearchDeveloperMetadata failed: %v", err)
+	}
+
+	matches := result.(map[string]interface{})["developer_metadata"].([]map[string]interface{})
+	if len(matches) != 1 {
+		t.Fatalf("Expected 1 match, got %d", len(matches))
+	}
+	location := matches[0]["location"].(map[string]interface{})
+	if location["sheet"] != "Records" || location["start"] != "5" || location["end"] != "5" {
+		t.Errorf("Unexpected location: %v", location)
+	}
+
+	if _, err := client.SearchDeveloperMetadata(context.Background(), "test-spreadsheet-id", MetadataLookup{}); err == nil {
+		t.Error("Expected error for empty lookup")
+	}
+}
+
+func TestReadByMetadata_Values(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/values:batchGetByDataFilter") {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&sheets.BatchGetValuesByDataFilterResponse{
+			ValueRanges: []*sheets.MatchedValueRange{
+				{ValueRange: &sheets.ValueRange{Range: "Records!A5:C5", Values: [][]interface{}{{"R-1001", "Alice", 42}}}},
+			},
+		})
+	}
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.ReadByMetadata(context.Background(), "test-spreadsheet-id", MetadataLookup{Key: "record_id", Value: "R-1001"})
+	if err != nil {
+		t.Fatalf("ReadByMetadata failed: %v", err)
+	}
+
+	ranges := result.(map[string]interface{})["value_ranges"].([]map[string]interface{})
+	if len(ranges) != 1 || ranges[0]["range"] != "Records!A5:C5" {
+		t.Fatalf("Unexpected value ranges: %v", ranges)
+	}
+	if values := ranges[0]["values"].([][]string); values[0][2] != "42" {
+		t.Errorf("Unexpected values: %v", values)
+	}
+}
+
+func TestDeleteDeveloperMetadata_Count(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{DeleteDeveloperMetadata: &sheets.DeleteDeveloperMetadataResponse{
+					DeletedDeveloperMetadata: []*sheets.DeveloperMetadata{{MetadataId: 1}, {MetadataId: 2}},
+				}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.DeleteDeveloperMetadata(context.Background(), "test-spreadsheet-id", MetadataLookup{Key: "stale", LocationType: "row"})
+	if err != nil {
+		t.Fatalf("DeleteDeveloperMetadata failed: %v", err)
+	}
+
+	if lookup := received.Requests[0].DeleteDeveloperMetadata.DataFilter.DeveloperMetadataLookup; lookup.LocationType != "ROW" {
+		t.Errorf("Unexpected lookup: %+v", lookup)
+	}
+	if result.(map[string]interface{})["deleted"] != 2 {
+		t.Errorf("Expected 2 deleted, got %v", result.(map[string]interface{})["deleted"])
+	}
+}
@@ -0,0 +1,308 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// diffFields requests what is needed to compare cells as a user would enter them
+const diffFields = "sheets(properties(title),data(startRow,startColumn,rowData(values(userEnteredValue,formattedValue))))"
+
+// currentCell is an existing cell as seen by diff_range
+type currentCell struct {
+	formatted string
+	entered   *sheets.ExtendedValue
+}
+
+// input is what a user would type to reproduce the cell: its formula if it
+// has one, otherwise its displayed value
+func (c currentCell) input() string {
+	if c.entered != nil && c.entered.FormulaValue != nil {
+		return *c.entered.FormulaValue
+	}
+	return c.formatted
+}
+
+// matches reports whether writing proposed would leave the cell unchanged.
+// Numbers and booleans match regardless of display formatting.
+func (c currentCell) matches(proposed string) bool {
+	if proposed == c.input() {
+		return true
+	}
+	if c.entered == nil || c.entered.FormulaValue != nil {
+		return false
+	}
+	if c.entered.NumberValue != nil {
+		n, err := strconv.ParseFloat(strings.TrimSpace(proposed), 64)
+		return err == nil && n == *c.entered.NumberValue
+	}
+	if c.entered.BoolValue != nil {
+		b, err := strconv.ParseBool(strings.TrimSpace(proposed))
+		return err == nil && b == *c.entered.BoolValue
+	}
+	return false
+}
+
+// cellAt returns the cell at row, column of grid, or an empty cell
+func cellAt(grid [][]currentCell, row, column int) currentCell {
+	if row < len(grid) && column < len(grid[row]) {
+		return grid[row][column]
+	}
+	return currentCell{}
+}
+
+// valueAt returns the proposed value at row, column, or "" outside the proposal
+func valueAt(values [][]string, row, column int) string {
+	if row < len(values) && column < len(values[row]) {
+		return values[row][column]
+	}
+	return ""
+}
+
+// gridWidth returns the length of the longest row
+func gridWidth[T any](rows [][]T) int {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	return width
+}
+
+// diffRange sizes the range to compare. A single cell anchors a range the
+// size of the proposal; a larger range must be able to hold the proposal.
+func diffRange(gr *sheets.GridRange, height, width int64) (*sheets.GridRange, error) {
+	sized := *gr
+	hasRows := gr.EndRowIndex > 0
+	hasCols := gr.EndColumnIndex > 0
+
+	if hasRows && hasCols && gr.EndRowIndex-gr.StartRowIndex == 1 && gr.EndColumnIndex-gr.StartColumnIndex == 1 {
+		sized.EndRowIndex = gr.StartRowIndex + max(height, 1)
+		sized.EndColumnIndex = gr.StartColumnIndex + max(width, 1)
+		return &sized, nil
+	}
+
+	if hasRows && height > gr.EndRowIndex-gr.StartRowIndex {
+		return nil, fmt.Errorf("proposed values have %d rows but the range has %d", height, gr.EndRowIndex-gr.StartRowIndex)
+	}
+	if hasCols && width > gr.EndColumnIndex-gr.StartColumnIndex {
+		return nil, fmt.Errorf("proposed values have %d columns but the range has %d", width, gr.EndColumnIndex-gr.StartColumnIndex)
+	}
+	return &sized, nil
+}
+
+// rowDiff compares rows below the header row, matched by the value in
+// keyColumn, and reports added, removed and changed rows
+func rowDiff(current [][]currentCell, proposed [][]string, gr *sheets.GridRange, keyColumn string) (map[string]interface{}, error) {
+	var headers []string
+	if len(current) > 0 {
+		for _, cell := range current[0] {
+			headers = append(headers, cell.input())
+		}
+	} else if len(proposed) > 0 {
+		headers = proposed[0]
+	}
+
+	offset, err := columnOffset(headers, gr, keyColumn)
+	if err != nil {
+		return nil, err
+	}
+	key := int(offset)
+
+	label := func(column int) string {
+		if column < len(headers) && headers[column] != "" {
+			return headers[column]
+		}
+		return indexToColumn(gr.StartColumnIndex + int64(column))
+	}
+	width := max(gridWidth(current), gridWidth(proposed))
+
+	currentRows := map[string]int{}
+	var duplicates []string
+	for r := 1; r < len(current); r++ {
+		k := cellAt(current, r, key).input()
+		if k == "" {
+			continue
+		}
+		if _, ok := currentRows[k]; ok {
+			duplicates = append(duplicates, k)
+		}
+		currentRows[k] = r
+	}
+
+	added := []map[string]interface{}{}
+	changed := []map[string]interface{}{}
+	seen := map[string]bool{}
+	for r := 1; r < len(proposed); r++ {
+		k := valueAt(proposed, r, key)
+		if k == "" {
+			continue
+		}
+		if seen[k] {
+			duplicates = append(duplicates, k)
+		}
+		seen[k] = true
+
+		cr, ok := currentRows[k]
+		if !ok {
+			added = append(added, map[string]interface{}{"key": k, "row": proposed[r]})
+			continue
+		}
+
+		changes := []map[string]interface{}{}
+		for col := 0; col < width; col++ {
+			cell, value := cellAt(current, cr, col), valueAt(proposed, r, col)
+			if !cell.matches(value) {
+				changes = append(changes, map[string]interface{}{"column": label(col), "old": cell.input(), "new": value})
+			}
+		}
+		if len(changes) > 0 {
+			changed = append(changed, map[string]interface{}{"key": k, "changes": changes})
+		}
+	}
+
+	removed := []map[string]interface{}{}
+	for r := 1; r < len(current); r++ {
+		k := cellAt(current, r, key).input()
+		if k == "" || seen[k] {
+			continue
+		}
+		row := make([]string, len(current[r]))
+		for col, cell := range current[r] {
+			row[col] = cell.input()
+		}
+		removed = append(removed, map[string]interface{}{"key": k, "row": row})
+	}
+
+	result := map[string]interface{}{
+		"key_column": label(key),
+		"added":      added,
+		"removed":    removed,
+		"changed":    changed,
+	}
+	if len(duplicates) > 0 {
+		result["duplicate_keys"] = duplicates
+	}
+	return result, nil
+}
+
+// currentGrid fetches the cells of gr, indexed from its top-left corner
+func (c *Client) currentGrid(ctx context.Context, spreadsheetID string, gr *sheets.GridRange, sheet *sheets.SheetProperties) ([][]currentCell, error) {
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Ranges(formatGridRange(sheet.Title, gr)).IncludeGridData(true).Fields(diffFields).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve current values: %v", err)
+	}
+
+	var current [][]currentCell
+	for _, s := range resp.Sheets {
+		for _, data := range s.Data {
+			rowOffset := int(data.StartRow - gr.StartRowIndex)
+			colOffset := int(data.StartColumn - gr.StartColumnIndex)
+			for r, row := range data.RowData {
+				for len(current) <= rowOffset+r {
+					current = append(current, nil)
+				}
+				cells := make([]currentCell, colOffset+len(row.Values))
+				for col, cell := range row.Values {
+					cells[colOffset+col] = currentCell{formatted: cell.FormattedValue, entered: cell.UserEnteredValue}
+				}
+				current[rowOffset+r] = cells
+			}
+		}
+	}
+	return current, nil
+}
+
+// cellChanges lists the cells writing proposed at the top-left corner of gr
+// would add, clear or change. When complete is set the proposal replaces
+// everything in current; otherwise only the cells it covers are compared,
+// as with a values update.
+func cellChanges(current [][]currentCell, proposed [][]string, gr *sheets.GridRange, sheetTitle string, complete bool) (added, removed, changed []map[string]interface{}, unchanged int) {
+	prefix := quoteSheetName(sheetTitle) + "!"
+	added = []map[string]interface{}{}
+	removed = []map[string]interface{}{}
+	changed = []map[string]interface{}{}
+
+	rows, width := len(proposed), gridWidth(proposed)
+	if complete {
+		rows = max(len(current), rows)
+		width = max(gridWidth(current), width)
+	}
+	for r := 0; r < rows; r++ {
+		for col := 0; col < width; col++ {
+			if !complete && (r >= len(proposed) || col >= len(proposed[r])) {
+				continue
+			}
+			cell, value := cellAt(current, r, col), valueAt(proposed, r, col)
+			old := cell.input()
+			address := prefix + cellAddress(gr.StartRowIndex+int64(r), gr.StartColumnIndex+int64(col))
+
+			switch {
+			case cell.matches(value):
+				if old != "" {
+					unchanged++
+				}
+			case old == "":
+				added = append(added, map[string]interface{}{"cell": address, "new": value})
+			case value == "":
+				removed = append(removed, map[string]interface{}{"cell": address, "old": old})
+			default:
+				changed = append(changed, map[string]interface{}{"cell": address, "old": old, "new": value})
+			}
+		}
+	}
+	return added, removed, changed, unchanged
+}
+
+// DiffRange compares proposed values with the current contents of a range
+// without changing anything. The proposal is treated as the complete new
+// contents: cells it leaves out count as cleared. When rangeA1 is a single
+// cell it marks the top-left corner of the proposal. With keyColumn (a header
+// name or column letter), rows below the header are also matched by key.
+func (c *Client) DiffRange(ctx context.Context, spreadsheetID, rangeA1 string, proposed [][]string, keyColumn string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if rangeA1 == "" {
+		return nil, fmt.Errorf("range is required")
+	}
+
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+	gr, err = diffRange(gr, int64(len(proposed)), int64(gridWidth(proposed)))
+	if err != nil {
+		return nil, err
+	}
+	compared := formatGridRange(sheet.Title, gr)
+
+	current, err := c.currentGrid(ctx, spreadsheetID, gr, sheet)
+	if err != nil {
+		return nil, err
+	}
+	added, removed, changed, unchanged := cellChanges(current, proposed, gr, sheet.Title, true)
+
+	result := map[string]interface{}{
+		"range":     compared,
+		"added":     added,
+		"removed":   removed,
+		"changed":   changed,
+		"unchanged": unchanged,
+		"identical": len(added)+len(removed)+len(changed) == 0,
+	}
+
+	if keyColumn != "" {
+		rowResult, err := rowDiff(current, proposed, gr, keyColumn)
+		if err != nil {
+			return nil, err
+		}
+		result["rows"] = rowResult
+	}
+
+	return result, nil
+}
@@ -0,0 +1,181 @@
+package sheets
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// gridSpreadsheet returns a one-sheet spreadsheet whose grid data starts at
+// the given cell
+func gridSpreadsheet(startRow, startColumn int64, rows ...[]*sheets.CellData) *sheets.Spreadsheet {
+	spreadsheet := testSpreadsheet("Data")
+	data := &sheets.GridData{StartRow: startRow, StartColumn: startColumn}
+	for _, row := range rows {
+		data.RowData = append(data.RowData, &sheets.RowData{Values: row})
+	}
+	spreadsheet.Sheets[0].Data = []*sheets.GridData{data}
+	return spreadsheet
+}
+
+func textCell(s string) *sheets.CellData {
+	return &sheets.CellData{FormattedValue: s, UserEnteredValue: &sheets.ExtendedValue{StringValue: &s}}
+}
+
+func numberCell(n float64, formatted string) *sheets.CellData {
+	return &sheets.CellData{FormattedValue: formatted, UserEnteredValue: &sheets.ExtendedValue{NumberValue: &n}}
+}
+
+func formulaCell(formula, formatted string) *sheets.CellData {
+	return &sheets.CellData{FormattedValue: formatted, UserEnteredValue: &sheets.ExtendedValue{FormulaValue: &formula}}
+}
+
+func TestDiffRange_Cells(t *testing.T) {
+	spreadsheet := gridSpreadsheet(1, 1,
+		[]*sheets.CellData{textCell("Name"), textCell("Price"), textCell("Total")},
+		[]*sheets.CellData{textCell("Widget"), numberCell(1200, "$1,200.00"), formulaCell("=C3*2", "$2,400.00")},
+		[]*sheets.CellData{textCell("Gadget"), numberCell(5, "5")},
+	)
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	proposed := [][]string{
+		{"Name", "Price", "Total"},
+		{"Widget", "1200", "=C3*3"},
+		{"", "5", "", "note"},
+	}
+
+	result, err := client.DiffRange(context.Background(), "test-spreadsheet-id", "Data!B2", proposed, "")
+	if err != nil {
+		t.Fatalf("DiffRange failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["range"] != "Data!B2:E4" {
+		t.Errorf("Expected anchor to expand to Data!B2:E4, got %v", resultMap["range"])
+	}
+	changed := resultMap["changed"].([]map[string]interface{})
+	if len(changed) != 1 || changed[0]["cell"] != "Data!D3" || changed[0]["old"] != "=C3*2" || changed[0]["new"] != "=C3*3" {
+		t.Errorf("Unexpected changed cells: %v", changed)
+	}
+	removed := resultMap["removed"].([]map[string]interface{})
+	if len(removed) != 1 || removed[0]["cell"] != "Data!B4" || removed[0]["old"] != "Gadget" {
+		t.Errorf("Unexpected removed cells: %v", removed)
+	}
+	added := resultMap["added"].([]map[string]interface{})
+	if len(added) != 1 || added[0]["cell"] != "Data!E4" || added[0]["new"] != "note" {
+		t.Errorf("Unexpected added cells: %v", added)
+	}
+	// Number values compare by value, not by their display format
+	if resultMap["unchanged"] != 6 {
+		t.Errorf("Expected 6 unchanged cells, got %v", resultMap["unchanged"])
+	}
+	if resultMap["identical"] != false {
+		t.Error("Expected identical to be false")
+	}
+}
+
+func TestDiffRange_Rows(t *testing.T) {
+	spreadsheet := gridSpreadsheet(0, 0,
+		[]*sheets.CellData{textCell("ID"), textCell("Name"), textCell("Qty")},
+		[]*sheets.CellData{textCell("1"), textCell("Widget"), numberCell(2, "2")},
+		[]*sheets.CellData{textCell("2"), textCell("Gadget"), numberCell(1, "1")},
+		[]*sheets.CellData{textCell("3"), textCell("Gizmo"), numberCell(4, "4")},
+	)
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	// Rows are reordered, one is dropped, one is edited and one is new
+	proposed := [][]string{
+		{"ID", "Name", "Qty"},
+		{"3", "Gizmo", "4"},
+		{"1", "Widget", "7"},
+		{"4", "Doohickey", "1"},
+	}
+
+	result, err := client.DiffRange(context.Background(), "test-spreadsheet-id", "Data!A1:C10", proposed, "id")
+	if err != nil {
+		t.Fatalf("DiffRange failed: %v", err)
+	}
+
+	rows := result.(map[string]interface{})["rows"].(map[string]interface{})
+	if rows["key_column"] != "ID" {
+		t.Errorf("Expected key column ID, got %v", rows["key_column"])
+	}
+	added := rows["added"].([]map[string]interface{})
+	if len(added) != 1 || added[0]["key"] != "4" {
+		t.Errorf("Unexpected added rows: %v", added)
+	}
+	removed := rows["removed"].([]map[string]interface{})
+	if len(removed) != 1 || removed[0]["key"] != "2" {
+		t.Errorf("Unexpected removed rows: %v", removed)
+	}
+	changed := rows["changed"].([]map[string]interface{})
+	if len(changed) != 1 || changed[0]["key"] != "1" {
+		t.Fatalf("Unexpected changed rows: %v", changed)
+	}
+	changes := changed[0]["changes"].([]map[string]interface{})
+	if len(changes) != 1 || changes[0]["column"] != "Qty" || changes[0]["old"] != "2" || changes[0]["new"] != "7" {
+		t.Errorf("Unexpected row changes: %v", changes)
+	}
+	if _, ok := rows["duplicate_keys"]; ok {
+		t.Error("Expected no duplicate keys")
+	}
+}
+
+func TestDiffRange_Identical(t *testing.T) {
+	yes := true
+	spreadsheet := gridSpreadsheet(0, 0,
+		[]*sheets.CellData{textCell("a"), {FormattedValue: "TRUE", UserEnteredValue: &sheets.ExtendedValue{BoolValue: &yes}}},
+	)
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	result, err := client.DiffRange(context.Background(), "test-spreadsheet-id", "Data!A1:B1", [][]string{{"a", "true"}}, "")
+	if err != nil {
+		t.Fatalf("DiffRange failed: %v", err)
+	}
+	if identical := result.(map[string]interface{})["identical"]; identical != true {
+		t.Errorf("Expected ranges to be identical, got %v", result)
+	}
+}
+
+func TestDiffRange_Validation(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, gridSpreadsheet(0, 0), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	tests := []struct {
+		name      string
+		rangeA1   string
+		values    [][]string
+		keyColumn string
+		wantErr   string
+	}{
+		{"missing range", "", [][]string{{"a"}}, "", "range is required"},
+		{"too many rows", "Data!A1:B2", [][]string{{"a"}, {"b"}, {"c"}}, "", "3 rows"},
+		{"too many columns", "Data!A1:B2", [][]string{{"a", "b", "c"}}, "", "3 columns"},
+		{"unknown key column", "Data!A1:B2", [][]string{{"ID", "Name"}}, "Missing", "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.DiffRange(context.Background(), "test-spreadsheet-id", tt.rangeA1, tt.values, tt.keyColumn)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDiffRange_NilService(t *testing.T) {
+	client := &Client{}
+	if _, err := client.DiffRange(context.Background(), "id", "A1", nil, ""); err == nil {
+		t.Error("Expected error with nil service")
+	}
+}
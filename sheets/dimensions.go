@@ -0,0 +1,285 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// DimensionSpan identifies a run of rows or columns on a sheet. Start and End
+// are inclusive and given as 1-based row numbers ("3") for ROWS or column
+// letters ("C") for COLUMNS. End defaults to Start.
+type DimensionSpan struct {
+	Sheet     string
+	Dimension string
+	Start     string
+	End       string
+}
+
+// normalizeDimension maps user input such as "rows" or "column" to the API enum
+func normalizeDimension(dimension string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(dimension)) {
+	case "ROW", "ROWS":
+		return "ROWS", nil
+	case "COLUMN", "COLUMNS", "COL", "COLS":
+		return "COLUMNS", nil
+	default:
+		return "", fmt.Errorf("invalid dimension %q (expected rows or columns)", dimension)
+	}
+}
+
+// dimensionIndex converts a 1-based row number or a column letter to a zero-based index
+func dimensionIndex(dimension, position string) (int64, error) {
+	position = strings.TrimSpace(position)
+	if dimension == "ROWS" {
+		n, err := strconv.ParseInt(position, 10, 64)
+		if err != nil || n < 1 {
+			return 0, fmt.Errorf("invalid row number %q (rows are numbered from 1)", position)
+		}
+		return n - 1, nil
+	}
+	return columnToIndex(position)
+}
+
+// dimensionLabel renders a zero-based index as a row number or column letter
+func dimensionLabel(dimension string, index int64) string {
+	if dimension == "ROWS" {
+		return strconv.FormatInt(index+1, 10)
+	}
+	return indexToColumn(index)
+}
+
+// dimensionRange resolves a DimensionSpan into a zero-based, end-exclusive sheets.DimensionRange
+func (c *Client) dimensionRange(ctx context.Context, spreadsheetID string, span DimensionSpan) (*sheets.DimensionRange, error) {
+	dimension, err := normalizeDimension(span.Dimension)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := dimensionIndex(dimension, span.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end := start
+	if span.End != "" {
+		if end, err = dimensionIndex(dimension, span.End); err != nil {
+			return nil, err
+		}
+	}
+	if end < start {
+		return nil, fmt.Errorf("end %q is before start %q", span.End, span.Start)
+	}
+
+	sheet, err := c.findSheet(ctx, spreadsheetID, span.Sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sheets.DimensionRange{
+		SheetId:         sheet.SheetId,
+		Dimension:       dimension,
+		StartIndex:      start,
+		EndIndex:        end + 1,
+		ForceSendFields: []string{"SheetId", "StartIndex"},
+	}, nil
+}
+
+// dimensionResult describes the affected rows or columns for tool output
+func dimensionResult(dr *sheets.DimensionRange, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"sheet_id":  dr.SheetId,
+		"dimension": dr.Dimension,
+		"start":     dimensionLabel(dr.Dimension, dr.StartIndex),
+		"end":       dimensionLabel(dr.Dimension, dr.EndIndex-1),
+		"count":     dr.EndIndex - dr.StartIndex,
+		"message":   message,
+	}
+}
+
+// applyDimensionRequest sends a single structural request and invalidates cached grid sizes
+func (c *Client) applyDimensionRequest(ctx context.Context, spreadsheetID string, request *sheets.Request, action string) error {
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{request},
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return fmt.Errorf("unable to %s: %v", action, err)
+	}
+	c.invalidateMetadata(spreadsheetID)
+	return nil
+}
+
+// InsertDimension inserts empty rows or columns at the span's position,
+// shifting existing ones down or right
+func (c *Client) InsertDimension(ctx context.Context, spreadsheetID string, span DimensionSpan, inheritFromBefore bool) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	dr, err := c.dimensionRange(ctx, spreadsheetID, span)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first row or column has nothing before it to inherit from
+	inheritFromBefore = inheritFromBefore && dr.StartIndex > 0
+
+	request := &sheets.Request{
+		InsertDimension: &sheets.InsertDimensionRequest{
+			Range:             dr,
+			InheritFromBefore: inheritFromBefore,
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, []*sheets.Request{request})
+	}
+
+	if err := c.applyDimensionRequest(ctx, spreadsheetID, request, "insert dimension"); err != nil {
+		return nil, err
+	}
+
+	return dimensionResult(dr, "Dimension inserted successfully"), nil
+}
+
+// DeleteDimension deletes rows or columns and their contents
+func (c *Client) DeleteDimension(ctx context.Context, spreadsheetID string, span DimensionSpan) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if span.Sheet == "" {
+		return nil, fmt.Errorf("sheet is required")
+	}
+
+	dr, err := c.dimensionRange(ctx, spreadsheetID, span)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &sheets.Request{
+		DeleteDimension: &sheets.DeleteDimensionRequest{Range: dr},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, []*sheets.Request{request})
+	}
+
+	if err := c.applyDimensionRequest(ctx, spreadsheetID, request, "delete dimension"); err != nil {
+		return nil, err
+	}
+
+	return dimensionResult(dr, "Dimension deleted successfully"), nil
+}
+
+// MoveDimension moves rows or columns so they start before destination, which
+// is a row number or column letter in the sheet's layout before the move
+func (c *Client) MoveDimension(ctx context.Context, spreadsheetID string, span DimensionSpan, destination string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if span.Sheet == "" {
+		return nil, fmt.Errorf("sheet is required")
+	}
+
+	dr, err := c.dimensionRange(ctx, spreadsheetID, span)
+	if err != nil {
+		return nil, err
+	}
+
+	destinationIndex, err := dimensionIndex(dr.Dimension, destination)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination: %v", err)
+	}
+	if destinationIndex > dr.StartIndex && destinationIndex < dr.EndIndex {
+		return nil, fmt.Errorf("destination %q is inside the range being moved", destination)
+	}
+
+	request := &sheets.Request{
+		MoveDimension: &sheets.MoveDimensionRequest{
+			Source:           dr,
+			DestinationIndex: destinationIndex,
+			ForceSendFields:  []string{"DestinationIndex"},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, []*sheets.Request{request})
+	}
+
+	if err := c.applyDimensionRequest(ctx, spreadsheetID, request, "move dimension"); err != nil {
+		return nil, err
+	}
+
+	result := dimensionResult(dr, "Dimension moved successfully")
+	result["destination"] = dimensionLabel(dr.Dimension, destinationIndex)
+	return result, nil
+}
+
+// ResizeDimension sets a fixed pixel height for rows or width for columns
+func (c *Client) ResizeDimension(ctx context.Context, spreadsheetID string, span DimensionSpan, pixelSize int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if pixelSize <= 0 {
+		return nil, fmt.Errorf("pixel_size must be positive")
+	}
+
+	dr, err := c.dimensionRange(ctx, spreadsheetID, span)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &sheets.Request{
+		UpdateDimensionProperties: &sheets.UpdateDimensionPropertiesRequest{
+			Range:      dr,
+			Properties: &sheets.DimensionProperties{PixelSize: pixelSize},
+			Fields:     "pixelSize",
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, []*sheets.Request{request})
+	}
+
+	if err := c.applyDimensionRequest(ctx, spreadsheetID, request, "resize dimension"); err != nil {
+		return nil, err
+	}
+
+	result := dimensionResult(dr, "Dimension resized successfully")
+	result["pixel_size"] = pixelSize
+	return result, nil
+}
+
+// AutoResizeDimension fits row heights or column widths to their contents
+func (c *Client) AutoResizeDimension(ctx context.Context, spreadsheetID string, span DimensionSpan) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	dr, err := c.dimensionRange(ctx, spreadsheetID, span)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &sheets.Request{
+		AutoResizeDimensions: &sheets.AutoResizeDimensionsRequest{Dimensions: dr},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, []*sheets.Request{request})
+	}
+
+	if err := c.applyDimensionRequest(ctx, spreadsheetID, request, "auto resize dimension"); err != nil {
+		return nil, err
+	}
+
+	return dimensionResult(dr, "Dimension auto-resized successfully"), nil
+}
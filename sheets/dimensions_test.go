@@ -0,0 +1,160 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestDimensionRange_Conversion(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Data"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := context.Background()
+
+	rows, err := client.dimensionRange(ctx, "test-spreadsheet-id", DimensionSpan{Sheet: "Data", Dimension: "rows", Start: "3", End: "5"})
+	if err != nil {
+		t.Fatalf("dimensionRange failed: %v", err)
+	}
+	if rows.SheetId != 1 || rows.Dimension != "ROWS" || rows.StartIndex != 2 || rows.EndIndex != 5 {
+		t.Errorf("Unexpected row range: %+v", rows)
+	}
+
+	cols, err := client.dimensionRange(ctx, "test-spreadsheet-id", DimensionSpan{Sheet: "Data", Dimension: "columns", Start: "B"})
+	if err != nil {
+		t.Fatalf("dimensionRange failed: %v", err)
+	}
+	if cols.StartIndex != 1 || cols.EndIndex != 2 {
+		t.Errorf("Unexpected column range: %+v", cols)
+	}
+
+	invalid := []DimensionSpan{
+		{Sheet: "Data", Dimension: "rows", Start: "0"},
+		{Sheet: "Data", Dimension: "rows", Start: "5", End: "3"},
+		{Sheet: "Data", Dimension: "cells", Start: "1"},
+		{Sheet: "Data", Dimension: "columns", Start: "1"},
+	}
+	for _, span := range invalid {
+		if _, err := client.dimensionRange(ctx, "test-spreadsheet-id", span); err == nil {
+			t.Errorf("Expected error for %+v", span)
+		}
+	}
+}
+
+func TestInsertDimension_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.InsertDimension(context.Background(), "test-spreadsheet-id", DimensionSpan{Sheet: "Sheet1", Dimension: "rows", Start: "2", End: "4"}, true)
+	if err != nil {
+		t.Fatalf("InsertDimension failed: %v", err)
+	}
+
+	insert := received.Requests[0].InsertDimension
+	if insert.Range.StartIndex != 1 || insert.Range.EndIndex != 4 || !insert.InheritFromBefore {
+		t.Errorf("Unexpected insert request: %+v", insert)
+	}
+	if result.(map[string]interface{})["count"] != int64(3) {
+		t.Errorf("Expected count 3, got %v", result.(map[string]interface{})["count"])
+	}
+}
+
+func TestMoveDimension_Columns(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	span := DimensionSpan{Sheet: "Sheet1", Dimension: "columns", Start: "D", End: "E"}
+
+	if _, err := client.MoveDimension(context.Background(), "test-spreadsheet-id", span, "A"); err != nil {
+		t.Fatalf("MoveDimension failed: %v", err)
+	}
+
+	move := received.Requests[0].MoveDimension
+	if move.Source.StartIndex != 3 || move.Source.EndIndex != 5 || move.DestinationIndex != 0 {
+		t.Errorf("Unexpected move request: %+v", move)
+	}
+
+	if _, err := client.MoveDimension(context.Background(), "test-spreadsheet-id", span, "E"); err == nil {
+		t.Error("Expected error when destination is inside the moved range")
+	}
+}
+
+func TestDimensionOps_MissingSheet(t *testing.T) {
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Sheet2"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		t.Errorf("Expected no batch update without a sheet, got %+v", req.Requests)
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := context.Background()
+	span := DimensionSpan{Dimension: "rows", Start: "2", End: "4"}
+
+	if _, err := client.DeleteDimension(ctx, "test-spreadsheet-id", span); err == nil || err.Error() != "sheet is required" {
+		t.Errorf("delete_dimension: expected sheet is required error, got %v", err)
+	}
+	if _, err := client.MoveDimension(ctx, "test-spreadsheet-id", span, "8"); err == nil || err.Error() != "sheet is required" {
+		t.Errorf("move_dimension: expected sheet is required error, got %v", err)
+	}
+}
+
+func TestResizeDimension_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.ResizeDimension(context.Background(), "test-spreadsheet-id", DimensionSpan{Dimension: "columns", Start: "A", End: "C"}, 150); err != nil {
+		t.Fatalf("ResizeDimension failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateDimensionProperties
+	if update.Properties.PixelSize != 150 || update.Fields != "pixelSize" || update.Range.EndIndex != 3 {
+		t.Errorf("Unexpected resize request: %+v", update)
+	}
+}
+
+func TestAutoResizeDimension_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.AutoResizeDimension(context.Background(), "test-spreadsheet-id", DimensionSpan{Dimension: "columns", Start: "A", End: "Z"}); err != nil {
+		t.Fatalf("AutoResizeDimension failed: %v", err)
+	}
+
+	if received.Requests[0].AutoResizeDimensions.Dimensions.EndIndex != 26 {
+		t.Errorf("Unexpected auto resize request: %+v", received.Requests[0].AutoResizeDimensions.Dimensions)
+	}
+}
@@ -0,0 +1,218 @@
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// gridRangeKeys are the bounds that identify a decoded object as a GridRange
+var gridRangeKeys = []string{"startRowIndex", "endRowIndex", "startColumnIndex", "endColumnIndex"}
+
+// dryRunResult marks a preview so it cannot be mistaken for applied changes
+func dryRunResult(spreadsheetID string, details map[string]interface{}) map[string]interface{} {
+	details["dry_run"] = true
+	details["message"] = dryrun.Message
+	if spreadsheetID != "" {
+		details["spreadsheet_id"] = spreadsheetID
+	}
+	return details
+}
+
+// jsonIndex reads an integer field decoded from JSON, which is absent when zero
+func jsonIndex(m map[string]interface{}, key string) int64 {
+	n, _ := m[key].(float64)
+	return int64(n)
+}
+
+// isGridRange reports whether a decoded object is a GridRange: row or column
+// bounds, or a sheet ID alone for a whole sheet
+func isGridRange(m map[string]interface{}) bool {
+	if _, ok := m["sheetId"]; ok && len(m) == 1 {
+		return true
+	}
+	for _, key := range gridRangeKeys {
+		if _, ok := m[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isDimensionRange reports whether a decoded object is a DimensionRange
+func isDimensionRange(m map[string]interface{}) bool {
+	_, hasDimension := m["dimension"]
+	_, hasStart := m["startIndex"]
+	_, hasEnd := m["endIndex"]
+	return hasDimension && (hasStart || hasEnd)
+}
+
+// requestRanges collects the grid and dimension ranges in a decoded request,
+// in A1 notation
+func requestRanges(v interface{}, meta *spreadsheetMetadata, ranges *[]string) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, item := range v {
+			requestRanges(item, meta, ranges)
+		}
+	case map[string]interface{}:
+		title := meta.sheetTitle(jsonIndex(v, "sheetId"))
+		switch {
+		case isDimensionRange(v):
+			gr := &sheets.GridRange{}
+			if v["dimension"] == "ROWS" {
+				gr.StartRowIndex, gr.EndRowIndex = jsonIndex(v, "startIndex"), jsonIndex(v, "endIndex")
+			} else {
+				gr.StartColumnIndex, gr.EndColumnIndex = jsonIndex(v, "startIndex"), jsonIndex(v, "endIndex")
+			}
+			*ranges = append(*ranges, formatGridRange(title, gr))
+			return
+		case isGridRange(v):
+			*ranges = append(*ranges, formatGridRange(title, &sheets.GridRange{
+				StartRowIndex:    jsonIndex(v, "startRowIndex"),
+				EndRowIndex:      jsonIndex(v, "endRowIndex"),
+				StartColumnIndex: jsonIndex(v, "startColumnIndex"),
+				EndColumnIndex:   jsonIndex(v, "endColumnIndex"),
+			}))
+			return
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			requestRanges(v[key], meta, ranges)
+		}
+	}
+}
+
+// previewRequests reports the structural changes a batch update would make
+// instead of sending it. Each change names its request type and lists the
+// ranges it touches in A1 notation.
+func (c *Client) previewRequests(ctx context.Context, spreadsheetID string, requests []*sheets.Request) (map[string]interface{}, error) {
+	meta, err := c.metadata(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]map[string]interface{}, 0, len(requests))
+	for _, request := range requests {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal request: %v", err)
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal request: %v", err)
+		}
+
+		kinds := make([]string, 0, len(fields))
+		for kind := range fields {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+		for _, kind := range kinds {
+			change := map[string]interface{}{"type": kind, "request": fields[kind]}
+			var ranges []string
+			requestRanges(fields[kind], meta, &ranges)
+			if len(ranges) > 0 {
+				change["ranges"] = ranges
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	return dryRunResult(spreadsheetID, map[string]interface{}{
+		"changes": changes,
+	}), nil
+}
+
+// previewValues reports the cells writing values at the top-left corner of
+// rangeA1 would add, clear or change. A larger range must be able to hold the
+// values, as the values endpoints require.
+func (c *Client) previewValues(ctx context.Context, spreadsheetID, rangeA1 string, values [][]string) (map[string]interface{}, error) {
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+	gr, err = diffRange(gr, int64(len(values)), int64(gridWidth(values)))
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.currentGrid(ctx, spreadsheetID, gr, sheet)
+	if err != nil {
+		return nil, err
+	}
+	added, removed, changed, unchanged := cellChanges(current, values, gr, sheet.Title, false)
+
+	return dryRunResult(spreadsheetID, map[string]interface{}{
+		"range":     formatGridRange(sheet.Title, gr),
+		"added":     added,
+		"removed":   removed,
+		"changed":   changed,
+		"unchanged": unchanged,
+	}), nil
+}
+
+// previewClear reports the non-empty cells clearing rangeA1 would empty
+func (c *Client) previewClear(ctx context.Context, spreadsheetID, rangeA1 string) (map[string]interface{}, error) {
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.currentGrid(ctx, spreadsheetID, gr, sheet)
+	if err != nil {
+		return nil, err
+	}
+	_, removed, _, _ := cellChanges(current, nil, gr, sheet.Title, true)
+
+	return dryRunResult(spreadsheetID, map[string]interface{}{
+		"range":   formatGridRange(sheet.Title, gr),
+		"removed": removed,
+	}), nil
+}
+
+// previewAppend reports where values would be appended: new rows inserted
+// below the last non-empty row of rangeA1, starting at its first column
+func (c *Client) previewAppend(ctx context.Context, spreadsheetID, rangeA1 string, values [][]string) (map[string]interface{}, error) {
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.currentGrid(ctx, spreadsheetID, gr, sheet)
+	if err != nil {
+		return nil, err
+	}
+	last := -1
+	for r, row := range current {
+		for _, cell := range row {
+			if cell.input() != "" {
+				last = r
+				break
+			}
+		}
+	}
+
+	target := &sheets.GridRange{
+		StartRowIndex:    gr.StartRowIndex + int64(last+1),
+		StartColumnIndex: gr.StartColumnIndex,
+	}
+	target.EndRowIndex = target.StartRowIndex + int64(max(len(values), 1))
+	target.EndColumnIndex = target.StartColumnIndex + int64(max(gridWidth(values), 1))
+	added, _, _, _ := cellChanges(nil, values, target, sheet.Title, false)
+
+	return dryRunResult(spreadsheetID, map[string]interface{}{
+		"updated_range": formatGridRange(sheet.Title, target),
+		"inserted_rows": len(values),
+		"added":         added,
+	}), nil
+}
@@ -0,0 +1,165 @@
+package sheets
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// noBatchUpdate fails the test if a dry run sends a batch update
+func noBatchUpdate(t *testing.T) func(*sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+	return func(*sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		t.Error("Dry run sent a batch update")
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	}
+}
+
+func TestWriteSheet_DryRun(t *testing.T) {
+	spreadsheet := gridSpreadsheet(0, 0,
+		[]*sheets.CellData{textCell("Name"), textCell("Qty")},
+		[]*sheets.CellData{textCell("Widget"), numberCell(2, "2")},
+	)
+	// Values updates are PUT requests, which the handler reports as unexpected
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, noBatchUpdate(t)))
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := dryrun.With(context.Background())
+	values := [][]string{{"Name", "Qty"}, {"Widget", "3"}, {"Gadget", ""}}
+
+	result, err := client.WriteSheet(ctx, "test-spreadsheet-id", "Data!A1", values)
+	if err != nil {
+		t.Fatalf("WriteSheet failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["dry_run"] != true || resultMap["message"] != dryrun.Message {
+		t.Errorf("Expected a dry-run result, got %v", resultMap)
+	}
+	if resultMap["range"] != "Data!A1:B3" {
+		t.Errorf("Expected range Data!A1:B3, got %v", resultMap["range"])
+	}
+	changed := resultMap["changed"].([]map[string]interface{})
+	if len(changed) != 1 || changed[0]["cell"] != "Data!B2" || changed[0]["new"] != "3" {
+		t.Errorf("Unexpected changed cells: %v", changed)
+	}
+	added := resultMap["added"].([]map[string]interface{})
+	if len(added) != 1 || added[0]["cell"] != "Data!A3" {
+		t.Errorf("Unexpected added cells: %v", added)
+	}
+	if resultMap["unchanged"] != 3 {
+		t.Errorf("Expected 3 unchanged cells, got %v", resultMap["unchanged"])
+	}
+}
+
+func TestClearSheet_DryRun(t *testing.T) {
+	spreadsheet := gridSpreadsheet(0, 0,
+		[]*sheets.CellData{textCell("Name"), textCell("Qty")},
+		[]*sheets.CellData{textCell("Widget")},
+	)
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, noBatchUpdate(t)))
+	defer server.Close()
+
+	client := NewClient(service)
+	result, err := client.ClearSheet(dryrun.With(context.Background()), "test-spreadsheet-id", "Data!A1:B2")
+	if err != nil {
+		t.Fatalf("ClearSheet failed: %v", err)
+	}
+
+	removed := result.(map[string]interface{})["removed"].([]map[string]interface{})
+	if len(removed) != 3 || removed[2]["cell"] != "Data!A2" || removed[2]["old"] != "Widget" {
+		t.Errorf("Unexpected cleared cells: %v", removed)
+	}
+}
+
+func TestAppendSheet_DryRun(t *testing.T) {
+	spreadsheet := gridSpreadsheet(0, 0,
+		[]*sheets.CellData{textCell("Name"), textCell("Qty")},
+		[]*sheets.CellData{textCell("Widget"), numberCell(2, "2")},
+	)
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, noBatchUpdate(t)))
+	defer server.Close()
+
+	client := NewClient(service)
+	result, err := client.AppendSheet(dryrun.With(context.Background()), "test-spreadsheet-id", "Data!A:B", [][]string{{"Gadget", "1"}})
+	if err != nil {
+		t.Fatalf("AppendSheet failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["updated_range"] != "Data!A3:B3" {
+		t.Errorf("Expected values appended at Data!A3:B3, got %v", resultMap["updated_range"])
+	}
+	if added := resultMap["added"].([]map[string]interface{}); len(added) != 2 {
+		t.Errorf("Expected 2 added cells, got %v", added)
+	}
+}
+
+func TestMergeCells_DryRun(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Data"), noBatchUpdate(t)))
+	defer server.Close()
+
+	client := NewClient(service)
+	result, err := client.MergeCells(dryrun.With(context.Background()), "test-spreadsheet-id", "Data!A1:B2", "")
+	if err != nil {
+		t.Fatalf("MergeCells failed: %v", err)
+	}
+
+	changes := result.(map[string]interface{})["changes"].([]map[string]interface{})
+	if len(changes) != 1 || changes[0]["type"] != "mergeCells" {
+		t.Fatalf("Unexpected changes: %v", changes)
+	}
+	if ranges := changes[0]["ranges"].([]string); len(ranges) != 1 || ranges[0] != "Data!A1:B2" {
+		t.Errorf("Expected range Data!A1:B2, got %v", ranges)
+	}
+}
+
+func TestInsertDimension_DryRun(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Data", "Other"), noBatchUpdate(t)))
+	defer server.Close()
+
+	client := NewClient(service)
+	span := DimensionSpan{Sheet: "Other", Dimension: "rows", Start: "3", End: "4"}
+	result, err := client.InsertDimension(dryrun.With(context.Background()), "test-spreadsheet-id", span, false)
+	if err != nil {
+		t.Fatalf("InsertDimension failed: %v", err)
+	}
+
+	changes := result.(map[string]interface{})["changes"].([]map[string]interface{})
+	if len(changes) != 1 || changes[0]["type"] != "insertDimension" {
+		t.Fatalf("Unexpected changes: %v", changes)
+	}
+	if ranges := changes[0]["ranges"].([]string); len(ranges) != 1 || ranges[0] != "Other!3:4" {
+		t.Errorf("Expected range Other!3:4, got %v", ranges)
+	}
+}
+
+func TestCreateSpreadsheetWithOptions_DryRun(t *testing.T) {
+	service, server := mockSheetsService(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Dry run sent a request: %s %s", r.Method, r.URL.Path)
+	})
+	defer server.Close()
+
+	client := NewClient(service)
+	opts := SpreadsheetOptions{Sheets: []SheetSpec{
+		{Title: "Data", RowCount: 100, Values: [][]string{{"a", "b"}, {"c"}}},
+	}}
+	result, err := client.CreateSpreadsheetWithOptions(dryrun.With(context.Background()), "Report", opts)
+	if err != nil {
+		t.Fatalf("CreateSpreadsheetWithOptions failed: %v", err)
+	}
+
+	sheetInfo := result.(map[string]interface{})["sheets"].([]map[string]interface{})
+	if sheetInfo[0]["row_count"] != int64(100) || sheetInfo[0]["column_count"] != int64(defaultColumnCount) || sheetInfo[0]["cells"] != 3 {
+		t.Errorf("Unexpected sheet preview: %v", sheetInfo[0])
+	}
+
+	// Invalid sheets are still rejected
+	opts.Sheets[0].RowCount = 1
+	if _, err := client.CreateSpreadsheetWithOptions(dryrun.With(context.Background()), "Report", opts); err == nil {
+		t.Error("Expected error for values that do not fit")
+	}
+}
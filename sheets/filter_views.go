@@ -0,0 +1,270 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// FilterViewOptions describes a filter view. Columns in SortSpecs and Criteria
+// are resolved against the header row of Range. On update, empty fields and
+// nil slices are left unchanged; an empty, non-nil slice clears them.
+type FilterViewOptions struct {
+	Title     string
+	Range     string
+	SortSpecs []SortSpec
+	Criteria  []FilterCriterion
+}
+
+// filterViewInfo summarises a filter view for tool output
+func filterViewInfo(fv *sheets.FilterView, titles map[int64]string) map[string]interface{} {
+	info := map[string]interface{}{
+		"filter_view_id": fv.FilterViewId,
+		"title":          fv.Title,
+	}
+	if fv.Range != nil {
+		info["range"] = formatGridRange(titles[fv.Range.SheetId], fv.Range)
+	}
+	if fv.NamedRangeId != "" {
+		info["named_range_id"] = fv.NamedRangeId
+	}
+	if len(fv.SortSpecs) > 0 {
+		info["sort_specs"] = sortSpecsInfo(fv.SortSpecs)
+	}
+	if len(fv.FilterSpecs) > 0 {
+		info["criteria"] = filterSpecsInfo(fv.FilterSpecs)
+	}
+	return info
+}
+
+// findFilterView returns an existing filter view by ID
+func (c *Client) findFilterView(ctx context.Context, spreadsheetID string, filterViewID int64) (*sheets.FilterView, error) {
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.filterViews").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve filter views: %v", err)
+	}
+
+	for _, sheet := range resp.Sheets {
+		for _, fv := range sheet.FilterViews {
+			if fv.FilterViewId == filterViewID {
+				return fv, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("filter view %d not found", filterViewID)
+}
+
+// buildFilterView converts options into a sheets.FilterView and the list of
+// fields that were set. existing supplies the range when opts.Range is empty.
+func (c *Client) buildFilterView(ctx context.Context, spreadsheetID string, opts FilterViewOptions, existing *sheets.GridRange) (*sheets.FilterView, []string, error) {
+	fv := &sheets.FilterView{}
+	var fields []string
+
+	if opts.Title != "" {
+		fv.Title = opts.Title
+		fields = append(fields, "title")
+	}
+
+	gr := existing
+	sheetTitle := ""
+	if opts.Range != "" {
+		resolved, sheet, err := c.resolveRange(ctx, spreadsheetID, opts.Range)
+		if err != nil {
+			return nil, nil, err
+		}
+		gr, sheetTitle = resolved, sheet.Title
+		fv.Range = gr
+		fields = append(fields, "range")
+	} else if gr != nil {
+		meta, err := c.metadata(ctx, spreadsheetID)
+		if err != nil {
+			return nil, nil, err
+		}
+		sheetTitle = meta.sheetTitle(gr.SheetId)
+	}
+
+	if len(opts.SortSpecs) > 0 || len(opts.Criteria) > 0 {
+		if gr == nil {
+			return nil, nil, fmt.Errorf("range is required to resolve columns")
+		}
+		headers, err := c.headerRow(ctx, spreadsheetID, gr, sheetTitle)
+		if err != nil {
+			return nil, nil, err
+		}
+		if fv.SortSpecs, err = buildSortSpecs(headers, gr, opts.SortSpecs); err != nil {
+			return nil, nil, err
+		}
+		if fv.FilterSpecs, err = buildFilterSpecs(headers, gr, opts.Criteria); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if opts.SortSpecs != nil {
+		fields = append(fields, "sortSpecs")
+	}
+	if opts.Criteria != nil {
+		fields = append(fields, "filterSpecs")
+	}
+
+	return fv, fields, nil
+}
+
+// CreateFilterView adds a named filter view, which lets each user filter and
+// sort a range without affecting what others see
+func (c *Client) CreateFilterView(ctx context.Context, spreadsheetID string, opts FilterViewOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if opts.Range == "" {
+		return nil, fmt.Errorf("range is required")
+	}
+
+	fv, _, err := c.buildFilterView(ctx, spreadsheetID, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				AddFilterView: &sheets.AddFilterViewRequest{Filter: fv},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create filter view: %v", err)
+	}
+
+	result := map[string]interface{}{
+		"title":   opts.Title,
+		"range":   opts.Range,
+		"message": "Filter view created successfully",
+	}
+	if len(resp.Replies) > 0 && resp.Replies[0].AddFilterView != nil && resp.Replies[0].AddFilterView.Filter != nil {
+		result["filter_view_id"] = resp.Replies[0].AddFilterView.Filter.FilterViewId
+	}
+
+	return result, nil
+}
+
+// ListFilterViews lists filter views, optionally limited to a single sheet
+func (c *Client) ListFilterViews(ctx context.Context, spreadsheetID, sheet string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties", "sheets.filterViews").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve filter views: %v", err)
+	}
+
+	titles := sheetTitles(resp.Sheets)
+	views := []map[string]interface{}{}
+	for _, s := range resp.Sheets {
+		if sheet != "" && s.Properties != nil && s.Properties.Title != sheet && fmt.Sprintf("%d", s.Properties.SheetId) != sheet {
+			continue
+		}
+		for _, fv := range s.FilterViews {
+			views = append(views, filterViewInfo(fv, titles))
+		}
+	}
+
+	return map[string]interface{}{
+		"filter_views": views,
+		"count":        len(views),
+	}, nil
+}
+
+// UpdateFilterView changes the title, range, sort or criteria of a filter view
+func (c *Client) UpdateFilterView(ctx context.Context, spreadsheetID string, filterViewID int64, opts FilterViewOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	var existing *sheets.GridRange
+	if opts.Range == "" && (len(opts.SortSpecs) > 0 || len(opts.Criteria) > 0) {
+		fv, err := c.findFilterView(ctx, spreadsheetID, filterViewID)
+		if err != nil {
+			return nil, err
+		}
+		existing = fv.Range
+	}
+
+	fv, fields, err := c.buildFilterView(ctx, spreadsheetID, opts, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no filter view changes specified")
+	}
+
+	fv.FilterViewId = filterViewID
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				UpdateFilterView: &sheets.UpdateFilterViewRequest{
+					Filter: fv,
+					Fields: strings.Join(fields, ","),
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to update filter view: %v", err)
+	}
+
+	return map[string]interface{}{
+		"filter_view_id": filterViewID,
+		"updated_fields": fields,
+		"message":        "Filter view updated successfully",
+	}, nil
+}
+
+// DeleteFilterView removes a filter view
+func (c *Client) DeleteFilterView(ctx context.Context, spreadsheetID string, filterViewID int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				DeleteFilterView: &sheets.DeleteFilterViewRequest{
+					FilterId:        filterViewID,
+					ForceSendFields: []string{"FilterId"},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to delete filter view: %v", err)
+	}
+
+	return map[string]interface{}{
+		"filter_view_id": filterViewID,
+		"message":        "Filter view deleted successfully",
+	}, nil
+}
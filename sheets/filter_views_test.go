@@ -0,0 +1,161 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestCreateFilterView_ByHeader(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Tasks"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{AddFilterView: &sheets.AddFilterViewResponse{Filter: &sheets.FilterView{FilterViewId: 42}}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, withHeaderRow(handler, "Task", "Owner", "Due"))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.CreateFilterView(context.Background(), "test-spreadsheet-id", FilterViewOptions{
+		Title:     "My tasks",
+		Range:     "Tasks!A1:C200",
+		SortSpecs: []SortSpec{{Column: "Due"}},
+		Criteria:  []FilterCriterion{{Column: "owner", Condition: "TEXT_EQ", ConditionValues: []string{"alex"}}},
+	})
+	if err != nil {
+		t.Fatalf("CreateFilterView failed: %v", err)
+	}
+
+	if result.(map[string]interface{})["filter_view_id"] != int64(42) {
+		t.Errorf("Expected filter_view_id 42, got %v", result.(map[string]interface{})["filter_view_id"])
+	}
+
+	fv := received.Requests[0].AddFilterView.Filter
+	if fv.Title != "My tasks" || fv.Range.SheetId != 1 {
+		t.Errorf("Unexpected filter view: %+v", fv)
+	}
+	if fv.SortSpecs[0].DimensionIndex != 2 || fv.FilterSpecs[0].ColumnIndex != 1 {
+		t.Errorf("Unexpected specs: sort=%+v filter=%+v", fv.SortSpecs[0], fv.FilterSpecs[0])
+	}
+}
+
+func TestUpdateFilterView_UsesExistingRange(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sheet1", "Tasks")
+	spreadsheet.Sheets[1].FilterViews = []*sheets.FilterView{
+		{FilterViewId: 42, Title: "My tasks", Range: &sheets.GridRange{SheetId: 1, StartColumnIndex: 0, EndColumnIndex: 3, EndRowIndex: 200}},
+	}
+
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, spreadsheet, func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, withHeaderRow(handler, "Task", "Owner", "Due"))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.UpdateFilterView(context.Background(), "test-spreadsheet-id", 42, FilterViewOptions{
+		SortSpecs: []SortSpec{{Column: "Owner", Order: "DESCENDING"}},
+		Criteria:  []FilterCriterion{},
+	})
+	if err != nil {
+		t.Fatalf("UpdateFilterView failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateFilterView
+	if update.Fields != "sortSpecs,filterSpecs" {
+		t.Errorf("Unexpected fields: %q", update.Fields)
+	}
+	if update.Filter.FilterViewId != 42 || update.Filter.SortSpecs[0].DimensionIndex != 1 {
+		t.Errorf("Unexpected filter view update: %+v", update.Filter)
+	}
+
+	if fields := result.(map[string]interface{})["updated_fields"].([]string); len(fields) != 2 {
+		t.Errorf("Expected 2 updated fields, got %v", fields)
+	}
+
+	if _, err := client.UpdateFilterView(context.Background(), "test-spreadsheet-id", 42, FilterViewOptions{}); err == nil {
+		t.Error("Expected error when no changes are specified")
+	}
+}
+
+func TestListFilterViews_Info(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sheet1", "Tasks")
+	spreadsheet.Sheets[1].FilterViews = []*sheets.FilterView{
+		{
+			FilterViewId: 42,
+			Title:        "Open",
+			Range:        &sheets.GridRange{SheetId: 1, StartRowIndex: 0, EndRowIndex: 10, StartColumnIndex: 0, EndColumnIndex: 3},
+			SortSpecs:    []*sheets.SortSpec{{DimensionIndex: 2, SortOrder: "ASCENDING"}},
+			FilterSpecs:  []*sheets.FilterSpec{{ColumnIndex: 1, FilterCriteria: &sheets.FilterCriteria{HiddenValues: []string{"Done"}}}},
+		},
+	}
+
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.ListFilterViews(context.Background(), "test-spreadsheet-id", "Tasks")
+	if err != nil {
+		t.Fatalf("ListFilterViews failed: %v", err)
+	}
+
+	views := result.(map[string]interface{})["filter_views"].([]map[string]interface{})
+	if len(views) != 1 {
+		t.Fatalf("Expected 1 filter view, got %d", len(views))
+	}
+	if views[0]["range"] != "Tasks!A1:C10" {
+		t.Errorf("Unexpected range: %v", views[0]["range"])
+	}
+	if sort := views[0]["sort_specs"].([]map[string]interface{}); sort[0]["column"] != "C" {
+		t.Errorf("Unexpected sort specs: %v", sort)
+	}
+	if criteria := views[0]["criteria"].([]map[string]interface{}); criteria[0]["column"] != "B" {
+		t.Errorf("Unexpected criteria: %v", criteria)
+	}
+
+	other, err := client.ListFilterViews(context.Background(), "test-spreadsheet-id", "Sheet1")
+	if err != nil {
+		t.Fatalf("ListFilterViews failed: %v", err)
+	}
+	if other.(map[string]interface{})["count"] != 0 {
+		t.Errorf("Expected no filter views on Sheet1, got %v", other.(map[string]interface{})["count"])
+	}
+}
+
+func TestGetSpreadsheetInfo_FilterViews(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sheet1", "Tasks")
+	spreadsheet.Properties = &sheets.SpreadsheetProperties{Title: "Tracker"}
+	spreadsheet.Sheets[1].FilterViews = []*sheets.FilterView{
+		{FilterViewId: 42, Title: "Open", Range: &sheets.GridRange{SheetId: 1, EndRowIndex: 10, EndColumnIndex: 3}},
+	}
+
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.GetSpreadsheetInfo(context.Background(), "test-spreadsheet-id")
+	if err != nil {
+		t.Fatalf("GetSpreadsheetInfo failed: %v", err)
+	}
+
+	sheetInfo := result.(map[string]interface{})["sheets"].([]map[string]interface{})
+	if _, ok := sheetInfo[0]["filter_views"]; ok {
+		t.Error("Expected no filter_views on a sheet without filter views")
+	}
+	views, ok := sheetInfo[1]["filter_views"].([]map[string]interface{})
+	if !ok || len(views) != 1 || views[0]["title"] != "Open" {
+		t.Errorf("Unexpected filter views: %v", sheetInfo[1]["filter_views"])
+	}
+}
@@ -0,0 +1,105 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// FindReplaceOptions configures a find and replace. At most one of Range and
+// Sheet may be set; when neither is, every sheet is searched.
+type FindReplaceOptions struct {
+	Find            string
+	Replacement     string
+	Range           string
+	Sheet           string
+	MatchCase       bool
+	MatchEntireCell bool
+	SearchByRegex   bool
+	IncludeFormulas bool
+}
+
+// FindReplace replaces matching text in cell values (and optionally formulas)
+// without rewriting the rest of the sheet
+func (c *Client) FindReplace(ctx context.Context, spreadsheetID string, opts FindReplaceOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if opts.Find == "" {
+		return nil, fmt.Errorf("find is required")
+	}
+	if opts.Range != "" && opts.Sheet != "" {
+		return nil, fmt.Errorf("specify either range or sheet, not both")
+	}
+
+	request := &sheets.FindReplaceRequest{
+		Find:            opts.Find,
+		Replacement:     opts.Replacement,
+		MatchCase:       opts.MatchCase,
+		MatchEntireCell: opts.MatchEntireCell,
+		SearchByRegex:   opts.SearchByRegex,
+		IncludeFormulas: opts.IncludeFormulas,
+		// An empty replacement deletes the matched text
+		ForceSendFields: []string{"Replacement"},
+	}
+
+	scope := "all sheets"
+	switch {
+	case opts.Range != "":
+		gr, sheet, err := c.resolveRange(ctx, spreadsheetID, opts.Range)
+		if err != nil {
+			return nil, err
+		}
+		request.Range = gr
+		scope = formatGridRange(sheet.Title, gr)
+	case opts.Sheet != "":
+		sheet, err := c.findSheet(ctx, spreadsheetID, opts.Sheet)
+		if err != nil {
+			return nil, err
+		}
+		request.SheetId = sheet.SheetId
+		request.ForceSendFields = append(request.ForceSendFields, "SheetId")
+		scope = sheet.Title
+	default:
+		request.AllSheets = true
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{FindReplace: request},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find and replace: %v", err)
+	}
+
+	result := map[string]interface{}{
+		"scope":               scope,
+		"occurrences_changed": int64(0),
+		"values_changed":      int64(0),
+		"formulas_changed":    int64(0),
+		"rows_changed":        int64(0),
+		"sheets_changed":      int64(0),
+		"message":             "Find and replace completed successfully",
+	}
+
+	if len(resp.Replies) > 0 && resp.Replies[0].FindReplace != nil {
+		reply := resp.Replies[0].FindReplace
+		result["occurrences_changed"] = reply.OccurrencesChanged
+		result["values_changed"] = reply.ValuesChanged
+		result["formulas_changed"] = reply.FormulasChanged
+		result["rows_changed"] = reply.RowsChanged
+		result["sheets_changed"] = reply.SheetsChanged
+	}
+
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestFindReplace_Scopes(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Data"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{FindReplace: &sheets.FindReplaceResponse{OccurrencesChanged: 4, ValuesChanged: 3, RowsChanged: 2, SheetsChanged: 1}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := context.Background()
+
+	result, err := client.FindReplace(ctx, "test-spreadsheet-id", FindReplaceOptions{Find: "N/A", Replacement: ""})
+	if err != nil {
+		t.Fatalf("FindReplace failed: %v", err)
+	}
+	if !received.Requests[0].FindReplace.AllSheets {
+		t.Error("Expected all sheets to be searched by default")
+	}
+	info := result.(map[string]interface{})
+	if info["occurrences_changed"] != int64(4) || info["rows_changed"] != int64(2) {
+		t.Errorf("Unexpected result: %v", info)
+	}
+
+	if _, err := client.FindReplace(ctx, "test-spreadsheet-id", FindReplaceOptions{Find: "^\\s+", SearchByRegex: true, Sheet: "Data"}); err != nil {
+		t.Fatalf("FindReplace failed: %v", err)
+	}
+	if fr := received.Requests[0].FindReplace; fr.SheetId != 1 || fr.AllSheets || !fr.SearchByRegex {
+		t.Errorf("Unexpected sheet-scoped request: %+v", fr)
+	}
+
+	if _, err := client.FindReplace(ctx, "test-spreadsheet-id", FindReplaceOptions{Find: "x", Range: "Data!B2:B10"}); err != nil {
+		t.Fatalf("FindReplace failed: %v", err)
+	}
+	if gr := received.Requests[0].FindReplace.Range; gr == nil || gr.SheetId != 1 || gr.StartColumnIndex != 1 || gr.EndRowIndex != 10 {
+		t.Errorf("Unexpected range-scoped request: %+v", gr)
+	}
+}
+
+func TestFindReplace_Validation(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.FindReplace(context.Background(), "test-spreadsheet-id", FindReplaceOptions{}); err == nil {
+		t.Error("Expected error when find is empty")
+	}
+	if _, err := client.FindReplace(context.Background(), "test-spreadsheet-id", FindReplaceOptions{Find: "a", Range: "Sheet1!A1", Sheet: "Sheet1"}); err == nil {
+		t.Error("Expected error when both range and sheet are given")
+	}
+}
@@ -0,0 +1,96 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// mergeTypes lists the merge modes accepted by MergeCellsRequest
+var mergeTypes = map[string]bool{
+	"MERGE_ALL":     true,
+	"MERGE_COLUMNS": true,
+	"MERGE_ROWS":    true,
+}
+
+// MergeCells merges the cells in a range. mergeType is MERGE_ALL (default),
+// MERGE_COLUMNS or MERGE_ROWS.
+func (c *Client) MergeCells(ctx context.Context, spreadsheetID, rangeA1, mergeType string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	mergeType = strings.ToUpper(mergeType)
+	if mergeType == "" {
+		mergeType = "MERGE_ALL"
+	}
+	if !mergeTypes[mergeType] {
+		return nil, fmt.Errorf("invalid merge_type %q (expected MERGE_ALL, MERGE_COLUMNS or MERGE_ROWS)", mergeType)
+	}
+
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				MergeCells: &sheets.MergeCellsRequest{
+					Range:     gr,
+					MergeType: mergeType,
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to merge cells: %v", err)
+	}
+
+	return map[string]interface{}{
+		"range":      formatGridRange(sheet.Title, gr),
+		"merge_type": mergeType,
+		"message":    "Cells merged successfully",
+	}, nil
+}
+
+// UnmergeCells breaks up every merge that overlaps a range
+func (c *Client) UnmergeCells(ctx context.Context, spreadsheetID, rangeA1 string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				UnmergeCells: &sheets.UnmergeCellsRequest{Range: gr},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to unmerge cells: %v", err)
+	}
+
+	return map[string]interface{}{
+		"range":   formatGridRange(sheet.Title, gr),
+		"message": "Cells unmerged successfully",
+	}, nil
+}
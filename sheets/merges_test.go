@@ -0,0 +1,60 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestMergeCells_DefaultType(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Report"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.MergeCells(context.Background(), "test-spreadsheet-id", "Report!A1:D1", "")
+	if err != nil {
+		t.Fatalf("MergeCells failed: %v", err)
+	}
+
+	merge := received.Requests[0].MergeCells
+	if merge.MergeType != "MERGE_ALL" || merge.Range.SheetId != 1 || merge.Range.EndColumnIndex != 4 {
+		t.Errorf("Unexpected merge request: %+v range=%+v", merge, merge.Range)
+	}
+	if result.(map[string]interface{})["range"] != "Report!A1:D1" {
+		t.Errorf("Unexpected range in result: %v", result.(map[string]interface{})["range"])
+	}
+
+	if _, err := client.MergeCells(context.Background(), "test-spreadsheet-id", "Report!A1:D1", "MERGE_DIAGONAL"); err == nil {
+		t.Error("Expected error for invalid merge type")
+	}
+}
+
+func TestUnmergeCells_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.UnmergeCells(context.Background(), "test-spreadsheet-id", "Sheet1!B2:C5"); err != nil {
+		t.Fatalf("UnmergeCells failed: %v", err)
+	}
+
+	gr := received.Requests[0].UnmergeCells.Range
+	if gr.StartRowIndex != 1 || gr.EndRowIndex != 5 || gr.StartColumnIndex != 1 || gr.EndColumnIndex != 3 {
+		t.Errorf("Unexpected unmerge range: %+v", gr)
+	}
+}
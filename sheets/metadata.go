@@ -0,0 +1,126 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// metadataTTL bounds how long cached spreadsheet metadata is reused. Changes
+// made through this client invalidate the cache immediately; the TTL covers
+// edits made elsewhere.
+const metadataTTL = time.Minute
+
+// metadataCache holds sheet properties and named ranges per spreadsheet
+type metadataCache struct {
+	mu      sync.Mutex
+	entries map[string]*spreadsheetMetadata
+}
+
+// spreadsheetMetadata is the structural metadata needed to resolve ranges
+type spreadsheetMetadata struct {
+	sheets      []*sheets.SheetProperties
+	namedRanges []*sheets.NamedRange
+	fetched     time.Time
+}
+
+// namedRangeName matches strings that may be a named range: letters, digits
+// and underscores, not starting with a digit
+var namedRangeName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// cellReference matches plain cell references such as "A1" or "AB12"
+var cellReference = regexp.MustCompile(`^[A-Za-z]{1,3}[0-9]+$`)
+
+// metadata returns the cached metadata for a spreadsheet, fetching it if
+// missing or stale
+func (c *Client) metadata(ctx context.Context, spreadsheetID string) (*spreadsheetMetadata, error) {
+	c.cache.mu.Lock()
+	entry, ok := c.cache.entries[spreadsheetID]
+	c.cache.mu.Unlock()
+
+	if ok && time.Since(entry.fetched) < metadataTTL {
+		return entry, nil
+	}
+
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties", "namedRanges").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve spreadsheet metadata: %v", err)
+	}
+
+	entry = &spreadsheetMetadata{
+		namedRanges: resp.NamedRanges,
+		fetched:     time.Now(),
+	}
+	for _, sheet := range resp.Sheets {
+		if sheet.Properties != nil {
+			entry.sheets = append(entry.sheets, sheet.Properties)
+		}
+	}
+
+	c.cache.mu.Lock()
+	if c.cache.entries == nil {
+		c.cache.entries = make(map[string]*spreadsheetMetadata)
+	}
+	c.cache.entries[spreadsheetID] = entry
+	c.cache.mu.Unlock()
+
+	return entry, nil
+}
+
+// invalidateMetadata drops cached metadata after a structural change
+func (c *Client) invalidateMetadata(spreadsheetID string) {
+	c.cache.mu.Lock()
+	delete(c.cache.entries, spreadsheetID)
+	c.cache.mu.Unlock()
+}
+
+// findNamedRange returns the named range with the given name or ID
+func (m *spreadsheetMetadata) findNamedRange(nameOrID string) *sheets.NamedRange {
+	for _, nr := range m.namedRanges {
+		if nr.Name == nameOrID || nr.NamedRangeId == nameOrID {
+			return nr
+		}
+	}
+	return nil
+}
+
+// sheetTitle returns the title of the sheet with the given ID
+func (m *spreadsheetMetadata) sheetTitle(sheetID int64) string {
+	for _, p := range m.sheets {
+		if p.SheetId == sheetID {
+			return p.Title
+		}
+	}
+	return ""
+}
+
+// mayBeNamedRange reports whether a range string could be a named range
+// rather than A1 notation
+func mayBeNamedRange(rangeA1 string) bool {
+	return namedRangeName.MatchString(rangeA1) && !cellReference.MatchString(rangeA1)
+}
+
+// resolveA1 replaces a named range with its A1 notation so it can be used
+// with the values endpoints. Any other range is returned unchanged.
+func (c *Client) resolveA1(ctx context.Context, spreadsheetID, rangeA1 string) (string, error) {
+	rangeA1 = strings.TrimSpace(rangeA1)
+	if !mayBeNamedRange(rangeA1) {
+		return rangeA1, nil
+	}
+
+	meta, err := c.metadata(ctx, spreadsheetID)
+	if err != nil {
+		return "", err
+	}
+
+	nr := meta.findNamedRange(rangeA1)
+	if nr == nil {
+		return rangeA1, nil
+	}
+	return formatGridRange(meta.sheetTitle(nr.Range.SheetId), nr.Range), nil
+}
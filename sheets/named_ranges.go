@@ -0,0 +1,214 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// sheetTitles maps sheet IDs to titles
+func sheetTitles(sheetList []*sheets.Sheet) map[int64]string {
+	titles := make(map[int64]string, len(sheetList))
+	for _, sheet := range sheetList {
+		if sheet.Properties != nil {
+			titles[sheet.Properties.SheetId] = sheet.Properties.Title
+		}
+	}
+	return titles
+}
+
+// namedRangeInfo summarises a named range for tool output
+func namedRangeInfo(nr *sheets.NamedRange, titles map[int64]string) map[string]interface{} {
+	info := map[string]interface{}{
+		"named_range_id": nr.NamedRangeId,
+		"name":           nr.Name,
+	}
+	if nr.Range != nil {
+		info["range"] = formatGridRange(titles[nr.Range.SheetId], nr.Range)
+	}
+	return info
+}
+
+// ListNamedRanges lists the named ranges defined in a spreadsheet
+func (c *Client) ListNamedRanges(ctx context.Context, spreadsheetID string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties", "namedRanges").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve named ranges: %v", err)
+	}
+
+	titles := sheetTitles(resp.Sheets)
+	namedRanges := make([]map[string]interface{}, len(resp.NamedRanges))
+	for i, nr := range resp.NamedRanges {
+		namedRanges[i] = namedRangeInfo(nr, titles)
+	}
+
+	return map[string]interface{}{
+		"named_ranges": namedRanges,
+		"count":        len(namedRanges),
+	}, nil
+}
+
+// CreateNamedRange defines a new named range over an A1 range
+func (c *Client) CreateNamedRange(ctx context.Context, spreadsheetID, name, rangeA1 string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				AddNamedRange: &sheets.AddNamedRangeRequest{
+					NamedRange: &sheets.NamedRange{
+						Name:  name,
+						Range: gr,
+					},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create named range: %v", err)
+	}
+	c.invalidateMetadata(spreadsheetID)
+
+	result := map[string]interface{}{
+		"name":    name,
+		"range":   formatGridRange(sheet.Title, gr),
+		"message": "Named range created successfully",
+	}
+	if len(resp.Replies) > 0 && resp.Replies[0].AddNamedRange != nil && resp.Replies[0].AddNamedRange.NamedRange != nil {
+		result["named_range_id"] = resp.Replies[0].AddNamedRange.NamedRange.NamedRangeId
+	}
+	return result, nil
+}
+
+// lookupNamedRange finds an existing named range by name or ID
+func (c *Client) lookupNamedRange(ctx context.Context, spreadsheetID, nameOrID string) (*sheets.NamedRange, error) {
+	// Always read fresh metadata so renames made elsewhere are seen
+	c.invalidateMetadata(spreadsheetID)
+	meta, err := c.metadata(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	nr := meta.findNamedRange(nameOrID)
+	if nr == nil {
+		return nil, fmt.Errorf("named range %q not found", nameOrID)
+	}
+	return nr, nil
+}
+
+// UpdateNamedRange renames a named range and/or changes the range it covers.
+// The named range is identified by its current name or ID.
+func (c *Client) UpdateNamedRange(ctx context.Context, spreadsheetID, nameOrID, newName, newRange string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if newName == "" && newRange == "" {
+		return nil, fmt.Errorf("new_name or range is required")
+	}
+
+	existing, err := c.lookupNamedRange(ctx, spreadsheetID, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := &sheets.NamedRange{NamedRangeId: existing.NamedRangeId}
+	var fields []string
+
+	if newName != "" {
+		updated.Name = newName
+		fields = append(fields, "name")
+	}
+	if newRange != "" {
+		gr, err := c.gridRange(ctx, spreadsheetID, newRange)
+		if err != nil {
+			return nil, err
+		}
+		updated.Range = gr
+		fields = append(fields, "range")
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				UpdateNamedRange: &sheets.UpdateNamedRangeRequest{
+					NamedRange: updated,
+					Fields:     strings.Join(fields, ","),
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to update named range: %v", err)
+	}
+	c.invalidateMetadata(spreadsheetID)
+
+	return map[string]interface{}{
+		"named_range_id": existing.NamedRangeId,
+		"updated_fields": fields,
+		"message":        "Named range updated successfully",
+	}, nil
+}
+
+// DeleteNamedRange removes a named range, identified by name or ID. The cells
+// it covered are left unchanged.
+func (c *Client) DeleteNamedRange(ctx context.Context, spreadsheetID, nameOrID string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.lookupNamedRange(ctx, spreadsheetID, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				DeleteNamedRange: &sheets.DeleteNamedRangeRequest{
+					NamedRangeId: existing.NamedRangeId,
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to delete named range: %v", err)
+	}
+	c.invalidateMetadata(spreadsheetID)
+
+	return map[string]interface{}{
+		"named_range_id": existing.NamedRangeId,
+		"name":           existing.Name,
+		"message":        "Named range deleted successfully",
+	}, nil
+}
@@ -0,0 +1,164 @@
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// namedRangeSpreadsheet returns spreadsheet metadata with a "Totals" named range on sheet "Data"
+func namedRangeSpreadsheet() *sheets.Spreadsheet {
+	spreadsheet := testSpreadsheet("Sheet1", "Data")
+	spreadsheet.NamedRanges = []*sheets.NamedRange{
+		{
+			NamedRangeId: "nr-1",
+			Name:         "Totals",
+			Range: &sheets.GridRange{
+				SheetId:          1,
+				StartRowIndex:    0,
+				EndRowIndex:      10,
+				StartColumnIndex: 1,
+				EndColumnIndex:   3,
+			},
+		},
+	}
+	return spreadsheet
+}
+
+func TestListNamedRanges_Success(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, namedRangeSpreadsheet(), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.ListNamedRanges(context.Background(), "test-spreadsheet-id")
+	if err != nil {
+		t.Fatalf("ListNamedRanges failed: %v", err)
+	}
+
+	namedRanges := result.(map[string]interface{})["named_ranges"].([]map[string]interface{})
+	if len(namedRanges) != 1 {
+		t.Fatalf("Expected 1 named range, got %d", len(namedRanges))
+	}
+	if namedRanges[0]["range"] != "Data!B1:C10" {
+		t.Errorf("Expected range 'Data!B1:C10', got %v", namedRanges[0]["range"])
+	}
+}
+
+func TestCreateNamedRange_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Data"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{AddNamedRange: &sheets.AddNamedRangeResponse{NamedRange: &sheets.NamedRange{NamedRangeId: "nr-2"}}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.CreateNamedRange(context.Background(), "test-spreadsheet-id", "Revenue", "Data!D2:D50")
+	if err != nil {
+		t.Fatalf("CreateNamedRange failed: %v", err)
+	}
+
+	if result.(map[string]interface{})["named_range_id"] != "nr-2" {
+		t.Errorf("Expected named_range_id 'nr-2', got %v", result.(map[string]interface{})["named_range_id"])
+	}
+
+	nr := received.Requests[0].AddNamedRange.NamedRange
+	if nr.Name != "Revenue" || nr.Range.SheetId != 1 || nr.Range.StartColumnIndex != 3 {
+		t.Errorf("Unexpected named range request: %+v %+v", nr, nr.Range)
+	}
+}
+
+func TestUpdateNamedRange_ByName(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, namedRangeSpreadsheet(), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.UpdateNamedRange(context.Background(), "test-spreadsheet-id", "Totals", "GrandTotals", ""); err != nil {
+		t.Fatalf("UpdateNamedRange failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateNamedRange
+	if update.NamedRange.NamedRangeId != "nr-1" || update.Fields != "name" {
+		t.Errorf("Unexpected update request: %+v", update)
+	}
+}
+
+func TestDeleteNamedRange_NotFound(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, namedRangeSpreadsheet(), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.DeleteNamedRange(context.Background(), "test-spreadsheet-id", "Missing"); err == nil {
+		t.Error("Expected error for unknown named range")
+	}
+}
+
+func TestGridRange_NamedRange(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, namedRangeSpreadsheet(), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	gr, err := client.gridRange(context.Background(), "test-spreadsheet-id", "Totals")
+	if err != nil {
+		t.Fatalf("gridRange failed: %v", err)
+	}
+	if gr.SheetId != 1 || gr.EndRowIndex != 10 || gr.EndColumnIndex != 3 {
+		t.Errorf("Unexpected grid range for named range: %+v", gr)
+	}
+}
+
+func TestReadSheet_NamedRangeUsesCachedMetadata(t *testing.T) {
+	metadataRequests := 0
+	var readPaths []string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "/values/") {
+			readPaths = append(readPaths, r.URL.Path)
+			json.NewEncoder(w).Encode(&sheets.ValueRange{Values: [][]interface{}{{"1"}}})
+			return
+		}
+		metadataRequests++
+		json.NewEncoder(w).Encode(namedRangeSpreadsheet())
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.ReadSheet(ctx, "test-spreadsheet-id", "Totals"); err != nil {
+			t.Fatalf("ReadSheet failed: %v", err)
+		}
+	}
+
+	if metadataRequests != 1 {
+		t.Errorf("Expected metadata to be fetched once, got %d", metadataRequests)
+	}
+	if len(readPaths) != 2 || !strings.Contains(readPaths[0], "Data!B1:C10") {
+		t.Errorf("Expected named range to resolve to Data!B1:C10, got %v", readPaths)
+	}
+}
@@ -0,0 +1,263 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// annotatedFields is the field mask used to read values together with notes and links
+const annotatedFields = "sheets(properties(title),data(startRow,startColumn,rowData(values(formattedValue,note,hyperlink,textFormatRuns(startIndex,format(link))))))"
+
+// SetNote sets the same note on every cell in a range. An empty note clears it.
+func (c *Client) SetNote(ctx context.Context, spreadsheetID, rangeA1, note string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				RepeatCell: &sheets.RepeatCellRequest{
+					Range:  gr,
+					Cell:   &sheets.CellData{Note: note},
+					Fields: "note",
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to set note: %v", err)
+	}
+
+	message := "Note set successfully"
+	if note == "" {
+		message = "Notes cleared successfully"
+	}
+
+	return map[string]interface{}{
+		"range":   formatGridRange(sheet.Title, gr),
+		"message": message,
+	}, nil
+}
+
+// hyperlinkFormula builds a =HYPERLINK formula, escaping embedded quotes
+func hyperlinkFormula(url, text string) string {
+	escape := func(s string) string { return strings.ReplaceAll(s, `"`, `""`) }
+	return fmt.Sprintf(`=HYPERLINK("%s","%s")`, escape(url), escape(text))
+}
+
+// hyperlinkRuns links linkText within text, or all of text when linkText is
+// empty. Indexes are in characters, as textFormatRuns expects.
+func hyperlinkRuns(text, linkText, url string) ([]*sheets.TextFormatRun, error) {
+	link := &sheets.TextFormat{Link: &sheets.Link{Uri: url}}
+	if linkText == "" || linkText == text {
+		return []*sheets.TextFormatRun{
+			{StartIndex: 0, Format: link, ForceSendFields: []string{"StartIndex"}},
+		}, nil
+	}
+
+	offset := strings.Index(text, linkText)
+	if offset < 0 {
+		return nil, fmt.Errorf("link_text %q not found in text", linkText)
+	}
+	start := int64(utf8.RuneCountInString(text[:offset]))
+	end := start + int64(utf8.RuneCountInString(linkText))
+
+	var runs []*sheets.TextFormatRun
+	if start > 0 {
+		runs = append(runs, &sheets.TextFormatRun{StartIndex: 0, Format: &sheets.TextFormat{}, ForceSendFields: []string{"StartIndex"}})
+	}
+	runs = append(runs, &sheets.TextFormatRun{StartIndex: start, Format: link, ForceSendFields: []string{"StartIndex"}})
+	if end < int64(utf8.RuneCountInString(text)) {
+		runs = append(runs, &sheets.TextFormatRun{StartIndex: end, Format: &sheets.TextFormat{}})
+	}
+	return runs, nil
+}
+
+// SetHyperlink writes a link into a single cell. By default the cell text is
+// rich text with a link over linkText (or the whole text); with useFormula it
+// is a =HYPERLINK formula instead. text defaults to the URL.
+func (c *Client) SetHyperlink(ctx context.Context, spreadsheetID, cell, url, text, linkText string, useFormula bool) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if url == "" {
+		return nil, fmt.Errorf("url is required")
+	}
+	if text == "" {
+		text = url
+	}
+	if useFormula && linkText != "" {
+		return nil, fmt.Errorf("link_text cannot be used with a HYPERLINK formula")
+	}
+
+	start, err := c.gridCoordinate(ctx, spreadsheetID, cell)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &sheets.CellData{}
+	fields := "userEnteredValue,textFormatRuns"
+	if useFormula {
+		formula := hyperlinkFormula(url, text)
+		data.UserEnteredValue = &sheets.ExtendedValue{FormulaValue: &formula}
+	} else {
+		data.UserEnteredValue = &sheets.ExtendedValue{StringValue: &text}
+		if data.TextFormatRuns, err = hyperlinkRuns(text, linkText, url); err != nil {
+			return nil, err
+		}
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				UpdateCells: &sheets.UpdateCellsRequest{
+					Start:  start,
+					Rows:   []*sheets.RowData{{Values: []*sheets.CellData{data}}},
+					Fields: fields,
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to set hyperlink: %v", err)
+	}
+
+	return map[string]interface{}{
+		"cell":    cell,
+		"url":     url,
+		"text":    text,
+		"message": "Hyperlink set successfully",
+	}, nil
+}
+
+// cellLinks returns the links in a cell, preferring per-run rich text links
+// over the cell-level hyperlink
+func cellLinks(cell *sheets.CellData) []map[string]interface{} {
+	var links []map[string]interface{}
+	text := []rune(cell.FormattedValue)
+	for i, run := range cell.TextFormatRuns {
+		if run.Format == nil || run.Format.Link == nil || run.Format.Link.Uri == "" {
+			continue
+		}
+		end := int64(len(text))
+		if i+1 < len(cell.TextFormatRuns) {
+			end = cell.TextFormatRuns[i+1].StartIndex
+		}
+		start := run.StartIndex
+		if start > end || end > int64(len(text)) {
+			start, end = 0, int64(len(text))
+		}
+		links = append(links, map[string]interface{}{
+			"url":  run.Format.Link.Uri,
+			"text": string(text[start:end]),
+		})
+	}
+
+	if len(links) == 0 && cell.Hyperlink != "" {
+		links = append(links, map[string]interface{}{
+			"url":  cell.Hyperlink,
+			"text": cell.FormattedValue,
+		})
+	}
+	return links
+}
+
+// ReadSheetWithNotes reads formatted values from a range along with any cell
+// notes and hyperlinks, using grid data rather than the values endpoint
+func (c *Client) ReadSheetWithNotes(ctx context.Context, spreadsheetID, readRange string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if readRange == "" {
+		readRange = "Sheet1"
+	} else {
+		resolved, err := c.resolveA1(ctx, spreadsheetID, readRange)
+		if err != nil {
+			return nil, err
+		}
+		readRange = resolved
+	}
+
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Ranges(readRange).IncludeGridData(true).Fields(annotatedFields).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve data from sheet: %v", err)
+	}
+
+	values := [][]string{}
+	notes := []map[string]interface{}{}
+	links := []map[string]interface{}{}
+
+	for _, sheet := range resp.Sheets {
+		title := ""
+		if sheet.Properties != nil {
+			title = sheet.Properties.Title
+		}
+		prefix := quoteSheetName(title) + "!"
+
+		for _, data := range sheet.Data {
+			for r, row := range data.RowData {
+				rowValues := make([]string, len(row.Values))
+				for col, cell := range row.Values {
+					rowValues[col] = cell.FormattedValue
+					address := prefix + cellAddress(data.StartRow+int64(r), data.StartColumn+int64(col))
+
+					if cell.Note != "" {
+						notes = append(notes, map[string]interface{}{
+							"cell": address,
+							"note": cell.Note,
+						})
+					}
+					for _, link := range cellLinks(cell) {
+						link["cell"] = address
+						links = append(links, link)
+					}
+				}
+				values = append(values, trimTrailingEmpty(rowValues))
+			}
+		}
+	}
+
+	// Match the values endpoint, which omits trailing empty rows
+	for len(values) > 0 && len(values[len(values)-1]) == 0 {
+		values = values[:len(values)-1]
+	}
+
+	return map[string]interface{}{
+		"range":     readRange,
+		"values":    values,
+		"row_count": len(values),
+		"notes":     notes,
+		"links":     links,
+	}, nil
+}
+
+// trimTrailingEmpty drops empty cells from the end of a row
+func trimTrailingEmpty(row []string) []string {
+	for len(row) > 0 && row[len(row)-1] == "" {
+		row = row[:len(row)-1]
+	}
+	return row
+}
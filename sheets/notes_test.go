@@ -0,0 +1,139 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestSetNote_Range(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.SetNote(context.Background(), "test-spreadsheet-id", "Sheet1!B2:B4", "Needs review")
+	if err != nil {
+		t.Fatalf("SetNote failed: %v", err)
+	}
+
+	repeat := received.Requests[0].RepeatCell
+	if repeat.Fields != "note" || repeat.Cell.Note != "Needs review" || repeat.Range.EndRowIndex != 4 {
+		t.Errorf("Unexpected repeat cell request: %+v", repeat)
+	}
+	if result.(map[string]interface{})["range"] != "Sheet1!B2:B4" {
+		t.Errorf("Unexpected range: %v", result.(map[string]interface{})["range"])
+	}
+}
+
+func TestHyperlinkRuns_PartialText(t *testing.T) {
+	runs, err := hyperlinkRuns("See ticket ABC-1 ✓ now", "ABC-1", "https://example.com/ABC-1")
+	if err != nil {
+		t.Fatalf("hyperlinkRuns failed: %v", err)
+	}
+	if len(runs) != 3 {
+		t.Fatalf("Expected 3 runs, got %d", len(runs))
+	}
+	if runs[1].StartIndex != 11 || runs[1].Format.Link.Uri != "https://example.com/ABC-1" {
+		t.Errorf("Unexpected link run: %+v", runs[1])
+	}
+	if runs[2].StartIndex != 16 || runs[2].Format.Link != nil {
+		t.Errorf("Unexpected trailing run: %+v", runs[2])
+	}
+
+	if _, err := hyperlinkRuns("text", "missing", "https://example.com"); err == nil {
+		t.Error("Expected error when link text is not in the cell text")
+	}
+}
+
+func TestSetHyperlink_Formula(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.SetHyperlink(context.Background(), "test-spreadsheet-id", "Sheet1!C3", "https://example.com", `Say "hi"`, "", true); err != nil {
+		t.Fatalf("SetHyperlink failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateCells
+	cell := update.Rows[0].Values[0]
+	if *cell.UserEnteredValue.FormulaValue != `=HYPERLINK("https://example.com","Say ""hi""")` {
+		t.Errorf("Unexpected formula: %s", *cell.UserEnteredValue.FormulaValue)
+	}
+	if update.Start.RowIndex != 2 || update.Start.ColumnIndex != 2 {
+		t.Errorf("Unexpected start: %+v", update.Start)
+	}
+}
+
+func TestReadSheetWithNotes_Success(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sheet1")
+	spreadsheet.Sheets[0].Data = []*sheets.GridData{
+		{
+			StartRow:    1,
+			StartColumn: 0,
+			RowData: []*sheets.RowData{
+				{Values: []*sheets.CellData{
+					{FormattedValue: "Task"},
+					{FormattedValue: "Ticket"},
+					{},
+				}},
+				{Values: []*sheets.CellData{
+					{FormattedValue: "Fix bug", Note: "Blocked on review"},
+					{FormattedValue: "ABC-1", Hyperlink: "https://example.com/ABC-1"},
+				}},
+				{Values: []*sheets.CellData{
+					{FormattedValue: "See ABC-2", TextFormatRuns: []*sheets.TextFormatRun{
+						{StartIndex: 0, Format: &sheets.TextFormat{}},
+						{StartIndex: 4, Format: &sheets.TextFormat{Link: &sheets.Link{Uri: "https://example.com/ABC-2"}}},
+					}},
+				}},
+			},
+		},
+	}
+
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.ReadSheetWithNotes(context.Background(), "test-spreadsheet-id", "Sheet1!A2:C4")
+	if err != nil {
+		t.Fatalf("ReadSheetWithNotes failed: %v", err)
+	}
+
+	info := result.(map[string]interface{})
+	values := info["values"].([][]string)
+	if len(values) != 3 || len(values[0]) != 2 || values[1][0] != "Fix bug" {
+		t.Errorf("Unexpected values: %v", values)
+	}
+
+	notes := info["notes"].([]map[string]interface{})
+	if len(notes) != 1 || notes[0]["cell"] != "Sheet1!A3" || notes[0]["note"] != "Blocked on review" {
+		t.Errorf("Unexpected notes: %v", notes)
+	}
+
+	links := info["links"].([]map[string]interface{})
+	if len(links) != 2 {
+		t.Fatalf("Expected 2 links, got %v", links)
+	}
+	if links[0]["cell"] != "Sheet1!B3" || links[0]["url"] != "https://example.com/ABC-1" {
+		t.Errorf("Unexpected cell link: %v", links[0])
+	}
+	if links[1]["cell"] != "Sheet1!A4" || links[1]["text"] != "ABC-2" {
+		t.Errorf("Unexpected rich text link: %v", links[1])
+	}
+}
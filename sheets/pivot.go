@@ -0,0 +1,209 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// PivotValue is a summarised column in a pivot table
+type PivotValue struct {
+	Column   string `json:"column"`
+	Function string `json:"function"`
+	Name     string `json:"name,omitempty"`
+}
+
+// PivotFilter restricts the source rows included in a pivot table. Rows are
+// kept if the column's value is one of VisibleValues, or if it satisfies
+// Condition (a BooleanCondition type such as NUMBER_GREATER) with ConditionValues.
+type PivotFilter struct {
+	Column          string   `json:"column"`
+	VisibleValues   []string `json:"visible_values,omitempty"`
+	Condition       string   `json:"condition,omitempty"`
+	ConditionValues []string `json:"condition_values,omitempty"`
+}
+
+// PivotTableOptions describes a pivot table. Columns are referenced by header name.
+type PivotTableOptions struct {
+	SourceRange string
+	TargetCell  string
+	Rows        []string
+	Columns     []string
+	Values      []PivotValue
+	Filters     []PivotFilter
+}
+
+// summarizeFunctions are the aggregations supported for pivot values
+var summarizeFunctions = map[string]bool{
+	"SUM":         true,
+	"COUNTA":      true,
+	"COUNT":       true,
+	"COUNTUNIQUE": true,
+	"AVERAGE":     true,
+	"MAX":         true,
+	"MIN":         true,
+	"MEDIAN":      true,
+	"PRODUCT":     true,
+	"STDEV":       true,
+	"STDEVP":      true,
+	"VAR":         true,
+	"VARP":        true,
+}
+
+// CreatePivotTable builds a pivot table from a source range and writes it at the target cell
+func (c *Client) CreatePivotTable(ctx context.Context, spreadsheetID string, opts PivotTableOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if opts.SourceRange == "" || opts.TargetCell == "" {
+		return nil, fmt.Errorf("source_range and target_cell are required")
+	}
+
+	if len(opts.Values) == 0 {
+		return nil, fmt.Errorf("at least one value aggregation is required")
+	}
+
+	source, sheet, err := c.resolveRange(ctx, spreadsheetID, opts.SourceRange)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source range: %v", err)
+	}
+
+	target, err := c.gridCoordinate(ctx, spreadsheetID, opts.TargetCell)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target cell: %v", err)
+	}
+
+	headers, err := c.headerRow(ctx, spreadsheetID, source, sheet.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	pivot := &sheets.PivotTable{
+		Source:      source,
+		ValueLayout: "HORIZONTAL",
+	}
+
+	groups := func(columns []string) ([]*sheets.PivotGroup, error) {
+		result := make([]*sheets.PivotGroup, len(columns))
+		for i, column := range columns {
+			offset, err := columnOffset(headers, source, column)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = &sheets.PivotGroup{
+				SourceColumnOffset: offset,
+				ShowTotals:         true,
+				SortOrder:          "ASCENDING",
+				ForceSendFields:    []string{"SourceColumnOffset"},
+			}
+		}
+		return result, nil
+	}
+
+	if pivot.Rows, err = groups(opts.Rows); err != nil {
+		return nil, err
+	}
+	if pivot.Columns, err = groups(opts.Columns); err != nil {
+		return nil, err
+	}
+
+	for _, v := range opts.Values {
+		function := strings.ToUpper(v.Function)
+		if function == "" {
+			function = "SUM"
+		}
+		if !summarizeFunctions[function] {
+			return nil, fmt.Errorf("unsupported summarize function %q", v.Function)
+		}
+
+		offset, err := columnOffset(headers, source, v.Column)
+		if err != nil {
+			return nil, err
+		}
+
+		pivot.Values = append(pivot.Values, &sheets.PivotValue{
+			SourceColumnOffset: offset,
+			SummarizeFunction:  function,
+			Name:               v.Name,
+			ForceSendFields:    []string{"SourceColumnOffset"},
+		})
+	}
+
+	for _, f := range opts.Filters {
+		offset, err := columnOffset(headers, source, f.Column)
+		if err != nil {
+			return nil, err
+		}
+
+		criteria := &sheets.PivotFilterCriteria{
+			VisibleValues: f.VisibleValues,
+		}
+		if f.Condition != "" {
+			criteria.Condition = booleanCondition(f.Condition, f.ConditionValues)
+		}
+
+		pivot.FilterSpecs = append(pivot.FilterSpecs, &sheets.PivotFilterSpec{
+			ColumnOffsetIndex: offset,
+			FilterCriteria:    criteria,
+			ForceSendFields:   []string{"ColumnOffsetIndex"},
+		})
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				UpdateCells: &sheets.UpdateCellsRequest{
+					Start: target,
+					Rows: []*sheets.RowData{
+						{Values: []*sheets.CellData{{PivotTable: pivot}}},
+					},
+					Fields: "pivotTable",
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to create pivot table: %v", err)
+	}
+
+	return map[string]interface{}{
+		"source_range": opts.SourceRange,
+		"target_cell":  opts.TargetCell,
+		"rows":         len(pivot.Rows),
+		"columns":      len(pivot.Columns),
+		"values":       len(pivot.Values),
+		"filters":      len(pivot.FilterSpecs),
+		"message":      "Pivot table created successfully",
+	}, nil
+}
+
+// booleanCondition builds a sheets.BooleanCondition from a condition type and its values
+func booleanCondition(conditionType string, values []string) *sheets.BooleanCondition {
+	condition := &sheets.BooleanCondition{Type: strings.ToUpper(conditionType)}
+	for _, v := range values {
+		condition.Values = append(condition.Values, &sheets.ConditionValue{UserEnteredValue: v})
+	}
+	return condition
+}
+
+// conditionValues renders the values of a sheets.BooleanCondition as strings
+func conditionValues(condition *sheets.BooleanCondition) []string {
+	var values []string
+	for _, v := range condition.Values {
+		if v.RelativeDate != "" {
+			values = append(values, v.RelativeDate)
+		} else {
+			values = append(values, v.UserEnteredValue)
+		}
+	}
+	return values
+}
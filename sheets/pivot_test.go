@@ -0,0 +1,117 @@
+package sheets
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// pivotHandler serves sheet metadata, a header row and captures the batchUpdate request
+func pivotHandler(t *testing.T, received **sheets.BatchUpdateSpreadsheetRequest) http.HandlerFunc {
+	metadata := mockSpreadsheetHandler(t, testSpreadsheet("Sales", "Summary"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		*received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	return withHeaderRow(metadata, "Region", "Quarter", "Product", "Revenue")
+}
+
+func TestCreatePivotTable_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	service, server := mockSheetsService(t, pivotHandler(t, &received))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.CreatePivotTable(context.Background(), "test-spreadsheet-id", PivotTableOptions{
+		SourceRange: "Sales!A1:D100",
+		TargetCell:  "Summary!A1",
+		Rows:        []string{"region"},
+		Columns:     []string{"Quarter"},
+		Values:      []PivotValue{{Column: "Revenue", Function: "sum"}},
+		Filters:     []PivotFilter{{Column: "Product", VisibleValues: []string{"Widget"}}},
+	})
+	if err != nil {
+		t.Fatalf("CreatePivotTable failed: %v", err)
+	}
+
+	if result.(map[string]interface{})["values"] != 1 {
+		t.Errorf("Expected 1 value, got %v", result.(map[string]interface{})["values"])
+	}
+
+	update := received.Requests[0].UpdateCells
+	if update.Start.SheetId != 1 {
+		t.Errorf("Expected target on sheet 1, got %d", update.Start.SheetId)
+	}
+
+	pivot := update.Rows[0].Values[0].PivotTable
+	if pivot.Rows[0].SourceColumnOffset != 0 {
+		t.Errorf("Expected row group offset 0, got %d", pivot.Rows[0].SourceColumnOffset)
+	}
+	if pivot.Columns[0].SourceColumnOffset != 1 {
+		t.Errorf("Expected column group offset 1, got %d", pivot.Columns[0].SourceColumnOffset)
+	}
+	if pivot.Values[0].SourceColumnOffset != 3 || pivot.Values[0].SummarizeFunction != "SUM" {
+		t.Errorf("Unexpected pivot value: %+v", pivot.Values[0])
+	}
+	if pivot.FilterSpecs[0].ColumnOffsetIndex != 2 {
+		t.Errorf("Expected filter offset 2, got %d", pivot.FilterSpecs[0].ColumnOffsetIndex)
+	}
+}
+
+func TestCreatePivotTable_UnknownHeader(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	service, server := mockSheetsService(t, pivotHandler(t, &received))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	_, err := client.CreatePivotTable(context.Background(), "test-spreadsheet-id", PivotTableOptions{
+		SourceRange: "Sales!A1:D100",
+		TargetCell:  "Summary!A1",
+		Rows:        []string{"Country"},
+		Values:      []PivotValue{{Column: "Revenue", Function: "SUM"}},
+	})
+	if err == nil {
+		t.Error("Expected error for unknown header")
+	}
+}
+
+func TestCreatePivotTable_InvalidFunction(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	service, server := mockSheetsService(t, pivotHandler(t, &received))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	_, err := client.CreatePivotTable(context.Background(), "test-spreadsheet-id", PivotTableOptions{
+		SourceRange: "Sales!A1:D100",
+		TargetCell:  "Summary!A1",
+		Values:      []PivotValue{{Column: "Revenue", Function: "TOTAL"}},
+	})
+	if err == nil {
+		t.Error("Expected error for unsupported summarize function")
+	}
+}
+
+func TestCreatePivotTable_RequiresRanges(t *testing.T) {
+	service, server := mockSheetsService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("No request expected: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := NewClient(service)
+	values := []PivotValue{{Column: "Revenue", Function: "SUM"}}
+
+	for _, opts := range []PivotTableOptions{
+		{SourceRange: "Sales!A1:D100", Values: values},
+		{TargetCell: "Summary!A1", Values: values},
+	} {
+		_, err := client.CreatePivotTable(context.Background(), "test-spreadsheet-id", opts)
+		if err == nil || err.Error() != "source_range and target_cell are required" {
+			t.Errorf("Expected required fields error for %+v, got %v", opts, err)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// ProtectionOptions describes a protected range. Either Range or Sheet is set;
+// Sheet protects a whole sheet, optionally leaving UnprotectedRanges editable.
+type ProtectionOptions struct {
+	Range              string
+	Sheet              string
+	UnprotectedRanges  []string
+	Description        string
+	WarningOnly        *bool
+	Editors            []string
+	Groups             []string
+	DomainUsersCanEdit *bool
+}
+
+// protectedRangeInfo summarises a protected range for tool output
+func protectedRangeInfo(pr *sheets.ProtectedRange, titles map[int64]string) map[string]interface{} {
+	info := map[string]interface{}{
+		"protected_range_id":       pr.ProtectedRangeId,
+		"description":              pr.Description,
+		"warning_only":             pr.WarningOnly,
+		"requesting_user_can_edit": pr.RequestingUserCanEdit,
+	}
+
+	if pr.NamedRangeId != "" {
+		info["named_range_id"] = pr.NamedRangeId
+	}
+
+	if pr.Range != nil {
+		title := titles[pr.Range.SheetId]
+		if pr.Range.EndRowIndex == 0 && pr.Range.EndColumnIndex == 0 {
+			info["sheet"] = title
+		} else {
+			info["range"] = formatGridRange(title, pr.Range)
+		}
+	}
+
+	if len(pr.UnprotectedRanges) > 0 {
+		unprotected := make([]string, len(pr.UnprotectedRanges))
+		for i, gr := range pr.UnprotectedRanges {
+			unprotected[i] = formatGridRange(titles[gr.SheetId], gr)
+		}
+		info["unprotected_ranges"] = unprotected
+	}
+
+	if pr.Editors != nil {
+		info["editors"] = map[string]interface{}{
+			"users":                 pr.Editors.Users,
+			"groups":                pr.Editors.Groups,
+			"domain_users_can_edit": pr.Editors.DomainUsersCanEdit,
+		}
+	}
+
+	return info
+}
+
+// buildProtectedRange converts ProtectionOptions into a sheets.ProtectedRange and the
+// field mask covering the options that were set
+func (c *Client) buildProtectedRange(ctx context.Context, spreadsheetID string, opts ProtectionOptions) (*sheets.ProtectedRange, []string, error) {
+	pr := &sheets.ProtectedRange{}
+	var fields []string
+
+	if opts.Range != "" && opts.Sheet != "" {
+		return nil, nil, fmt.Errorf("specify either range or sheet, not both")
+	}
+
+	if opts.Range != "" {
+		gr, err := c.gridRange(ctx, spreadsheetID, opts.Range)
+		if err != nil {
+			return nil, nil, err
+		}
+		pr.Range = gr
+		fields = append(fields, "range")
+	}
+
+	if opts.Sheet != "" {
+		sheet, err := c.findSheet(ctx, spreadsheetID, opts.Sheet)
+		if err != nil {
+			return nil, nil, err
+		}
+		pr.Range = &sheets.GridRange{SheetId: sheet.SheetId, ForceSendFields: []string{"SheetId"}}
+		fields = append(fields, "range")
+	}
+
+	if len(opts.UnprotectedRanges) > 0 {
+		for _, r := range opts.UnprotectedRanges {
+			gr, err := c.gridRange(ctx, spreadsheetID, r)
+			if err != nil {
+				return nil, nil, err
+			}
+			pr.UnprotectedRanges = append(pr.UnprotectedRanges, gr)
+		}
+		fields = append(fields, "unprotectedRanges")
+	}
+
+	if opts.Description != "" {
+		pr.Description = opts.Description
+		fields = append(fields, "description")
+	}
+
+	if opts.WarningOnly != nil {
+		pr.WarningOnly = *opts.WarningOnly
+		pr.ForceSendFields = append(pr.ForceSendFields, "WarningOnly")
+		fields = append(fields, "warningOnly")
+	}
+
+	if opts.Editors != nil || opts.Groups != nil || opts.DomainUsersCanEdit != nil {
+		if opts.WarningOnly != nil && *opts.WarningOnly {
+			return nil, nil, fmt.Errorf("editors cannot be set on a warning-only protected range")
+		}
+		pr.Editors = &sheets.Editors{
+			Users:  opts.Editors,
+			Groups: opts.Groups,
+		}
+		if opts.DomainUsersCanEdit != nil {
+			pr.Editors.DomainUsersCanEdit = *opts.DomainUsersCanEdit
+			pr.Editors.ForceSendFields = []string{"DomainUsersCanEdit"}
+		}
+		fields = append(fields, "editors")
+	}
+
+	return pr, fields, nil
+}
+
+// AddProtectedRange protects a range or a whole sheet
+func (c *Client) AddProtectedRange(ctx context.Context, spreadsheetID string, opts ProtectionOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if opts.Range == "" && opts.Sheet == "" {
+		return nil, fmt.Errorf("range or sheet is required")
+	}
+
+	pr, _, err := c.buildProtectedRange(ctx, spreadsheetID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				AddProtectedRange: &sheets.AddProtectedRangeRequest{
+					ProtectedRange: pr,
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to add protected range: %v", err)
+	}
+
+	result := map[string]interface{}{
+		"message": "Protected range added successfully",
+	}
+	if len(resp.Replies) > 0 && resp.Replies[0].AddProtectedRange != nil && resp.Replies[0].AddProtectedRange.ProtectedRange != nil {
+		result["protected_range_id"] = resp.Replies[0].AddProtectedRange.ProtectedRange.ProtectedRangeId
+	}
+	return result, nil
+}
+
+// ListProtectedRanges lists protected ranges and protected sheets, optionally
+// limited to a single sheet
+func (c *Client) ListProtectedRanges(ctx context.Context, spreadsheetID, sheet string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties", "sheets.protectedRanges").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve protected ranges: %v", err)
+	}
+
+	titles := sheetTitles(resp.Sheets)
+	protected := []map[string]interface{}{}
+	for _, s := range resp.Sheets {
+		if sheet != "" && s.Properties != nil && s.Properties.Title != sheet && fmt.Sprintf("%d", s.Properties.SheetId) != sheet {
+			continue
+		}
+		for _, pr := range s.ProtectedRanges {
+			protected = append(protected, protectedRangeInfo(pr, titles))
+		}
+	}
+
+	return map[string]interface{}{
+		"protected_ranges": protected,
+		"count":            len(protected),
+	}, nil
+}
+
+// UpdateProtectedRange changes the options of an existing protected range.
+// Only the options that are set are updated.
+func (c *Client) UpdateProtectedRange(ctx context.Context, spreadsheetID string, protectedRangeID int64, opts ProtectionOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	pr, fields, err := c.buildProtectedRange(ctx, spreadsheetID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no protected range changes specified")
+	}
+
+	pr.ProtectedRangeId = protectedRangeID
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				UpdateProtectedRange: &sheets.UpdateProtectedRangeRequest{
+					ProtectedRange: pr,
+					Fields:         strings.Join(fields, ","),
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to update protected range: %v", err)
+	}
+
+	return map[string]interface{}{
+		"protected_range_id": protectedRangeID,
+		"updated_fields":     fields,
+		"message":            "Protected range updated successfully",
+	}, nil
+}
+
+// DeleteProtectedRange removes protection from a range or sheet
+func (c *Client) DeleteProtectedRange(ctx context.Context, spreadsheetID string, protectedRangeID int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				DeleteProtectedRange: &sheets.DeleteProtectedRangeRequest{
+					ProtectedRangeId: protectedRangeID,
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to delete protected range: %v", err)
+	}
+
+	return map[string]interface{}{
+		"protected_range_id": protectedRangeID,
+		"message":            "Protected range removed successfully",
+	}, nil
+}
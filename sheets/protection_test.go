@@ -0,0 +1,136 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestAddProtectedRange_Range(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{AddProtectedRange: &sheets.AddProtectedRangeResponse{ProtectedRange: &sheets.ProtectedRange{ProtectedRangeId: 5}}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.AddProtectedRange(context.Background(), "test-spreadsheet-id", ProtectionOptions{
+		Range:       "Sheet1!1:1",
+		Description: "Header row",
+		Editors:     []string{"owner@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("AddProtectedRange failed: %v", err)
+	}
+
+	if result.(map[string]interface{})["protected_range_id"] != int64(5) {
+		t.Errorf("Expected protected_range_id 5, got %v", result.(map[string]interface{})["protected_range_id"])
+	}
+
+	pr := received.Requests[0].AddProtectedRange.ProtectedRange
+	if pr.Range.EndRowIndex != 1 || pr.Description != "Header row" || pr.Editors.Users[0] != "owner@example.com" {
+		t.Errorf("Unexpected protected range: %+v", pr)
+	}
+}
+
+func TestAddProtectedRange_WarningOnlyWithEditors(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	warningOnly := true
+
+	_, err := client.AddProtectedRange(context.Background(), "test-spreadsheet-id", ProtectionOptions{
+		Sheet:       "Sheet1",
+		WarningOnly: &warningOnly,
+		Editors:     []string{"owner@example.com"},
+	})
+	if err == nil {
+		t.Error("Expected error when combining warning_only with editors")
+	}
+}
+
+func TestListProtectedRanges_Success(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sheet1", "Data")
+	spreadsheet.Sheets[1].ProtectedRanges = []*sheets.ProtectedRange{
+		{ProtectedRangeId: 1, Range: &sheets.GridRange{SheetId: 1}, WarningOnly: true},
+		{ProtectedRangeId: 2, Range: &sheets.GridRange{SheetId: 1, StartColumnIndex: 3, EndColumnIndex: 4}},
+	}
+
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.ListProtectedRanges(context.Background(), "test-spreadsheet-id", "")
+	if err != nil {
+		t.Fatalf("ListProtectedRanges failed: %v", err)
+	}
+
+	protected := result.(map[string]interface{})["protected_ranges"].([]map[string]interface{})
+	if len(protected) != 2 {
+		t.Fatalf("Expected 2 protected ranges, got %d", len(protected))
+	}
+	if protected[0]["sheet"] != "Data" {
+		t.Errorf("Expected whole-sheet protection on 'Data', got %v", protected[0])
+	}
+	if protected[1]["range"] != "Data!D:D" {
+		t.Errorf("Expected range 'Data!D:D', got %v", protected[1]["range"])
+	}
+}
+
+func TestUpdateProtectedRange_FieldMask(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	warningOnly := false
+
+	if _, err := client.UpdateProtectedRange(context.Background(), "test-spreadsheet-id", 9, ProtectionOptions{
+		Description: "Formulas",
+		WarningOnly: &warningOnly,
+	}); err != nil {
+		t.Fatalf("UpdateProtectedRange failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateProtectedRange
+	if update.Fields != "description,warningOnly" || update.ProtectedRange.ProtectedRangeId != 9 {
+		t.Errorf("Unexpected update request: fields=%q id=%d", update.Fields, update.ProtectedRange.ProtectedRangeId)
+	}
+}
+
+func TestDeleteProtectedRange_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, nil, func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.DeleteProtectedRange(context.Background(), "test-spreadsheet-id", 9); err != nil {
+		t.Fatalf("DeleteProtectedRange failed: %v", err)
+	}
+
+	if received.Requests[0].DeleteProtectedRange.ProtectedRangeId != 9 {
+		t.Errorf("Expected protected range ID 9, got %d", received.Requests[0].DeleteProtectedRange.ProtectedRangeId)
+	}
+}
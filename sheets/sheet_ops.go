@@ -0,0 +1,382 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// sheetPropertiesInfo summarises sheet properties for tool output
+func sheetPropertiesInfo(props *sheets.SheetProperties) map[string]interface{} {
+	info := map[string]interface{}{
+		"sheet_id":   props.SheetId,
+		"title":      props.Title,
+		"index":      props.Index,
+		"sheet_type": props.SheetType,
+	}
+
+	// Chart sheets (sheet_type OBJECT) have no grid properties
+	if grid := props.GridProperties; grid != nil {
+		info["row_count"] = grid.RowCount
+		info["col_count"] = grid.ColumnCount
+		info["frozen_rows"] = grid.FrozenRowCount
+		info["frozen_cols"] = grid.FrozenColumnCount
+		if grid.HideGridlines {
+			info["hide_gridlines"] = true
+		}
+	}
+
+	if props.Hidden {
+		info["hidden"] = true
+	}
+
+	if tab := hexColorStyle(props.TabColorStyle); tab != "" {
+		info["tab_color"] = tab
+	}
+
+	return info
+}
+
+// updateSheetProperties applies a sheet properties update and reports the resulting properties
+func (c *Client) updateSheetProperties(ctx context.Context, spreadsheetID, sheet string, props *sheets.SheetProperties, fields, message string) (interface{}, error) {
+	existing, err := c.findSheet(ctx, spreadsheetID, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	props.SheetId = existing.SheetId
+	props.ForceSendFields = append(props.ForceSendFields, "SheetId")
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				UpdateSheetProperties: &sheets.UpdateSheetPropertiesRequest{
+					Properties: props,
+					Fields:     fields,
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to update sheet properties: %v", err)
+	}
+	c.invalidateMetadata(spreadsheetID)
+
+	updated, err := c.findSheet(ctx, spreadsheetID, fmt.Sprintf("%d", existing.SheetId))
+	if err != nil {
+		return nil, err
+	}
+
+	result := sheetPropertiesInfo(updated)
+	result["message"] = message
+	return result, nil
+}
+
+// RenameSheet changes the title of a sheet, identified by title or sheet ID
+func (c *Client) RenameSheet(ctx context.Context, spreadsheetID, sheet, newTitle string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if sheet == "" {
+		return nil, fmt.Errorf("sheet is required")
+	}
+
+	if newTitle == "" {
+		return nil, fmt.Errorf("new_title is required")
+	}
+
+	return c.updateSheetProperties(ctx, spreadsheetID, sheet, &sheets.SheetProperties{Title: newTitle}, "title", "Sheet renamed successfully")
+}
+
+// MoveSheet moves a sheet to a zero-based tab index
+func (c *Client) MoveSheet(ctx context.Context, spreadsheetID, sheet string, index int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if sheet == "" {
+		return nil, fmt.Errorf("sheet is required")
+	}
+
+	if index < 0 {
+		return nil, fmt.Errorf("index must not be negative")
+	}
+
+	props := &sheets.SheetProperties{Index: index, ForceSendFields: []string{"Index"}}
+	return c.updateSheetProperties(ctx, spreadsheetID, sheet, props, "index", "Sheet moved successfully")
+}
+
+// SetSheetHidden hides or unhides a sheet
+func (c *Client) SetSheetHidden(ctx context.Context, spreadsheetID, sheet string, hidden bool) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if sheet == "" {
+		return nil, fmt.Errorf("sheet is required")
+	}
+
+	message := "Sheet unhidden successfully"
+	if hidden {
+		message = "Sheet hidden successfully"
+	}
+
+	props := &sheets.SheetProperties{Hidden: hidden, ForceSendFields: []string{"Hidden"}}
+	return c.updateSheetProperties(ctx, spreadsheetID, sheet, props, "hidden", message)
+}
+
+// SetTabColor sets a sheet's tab color from a hex string. An empty color clears it.
+func (c *Client) SetTabColor(ctx context.Context, spreadsheetID, sheet, color string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if sheet == "" {
+		return nil, fmt.Errorf("sheet is required")
+	}
+
+	props := &sheets.SheetProperties{}
+	if color != "" {
+		style, err := colorStyle(color)
+		if err != nil {
+			return nil, err
+		}
+		props.TabColorStyle = style
+	}
+
+	return c.updateSheetProperties(ctx, spreadsheetID, sheet, props, "tabColorStyle", "Tab color updated successfully")
+}
+
+// FreezePanes sets the number of frozen rows and columns on a sheet. A nil
+// count is left unchanged and zero unfreezes.
+func (c *Client) FreezePanes(ctx context.Context, spreadsheetID, sheet string, rows, columns *int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if rows == nil && columns == nil {
+		return nil, fmt.Errorf("rows or columns is required")
+	}
+
+	grid := &sheets.GridProperties{}
+	var fields []string
+	if rows != nil {
+		if *rows < 0 {
+			return nil, fmt.Errorf("rows must not be negative")
+		}
+		grid.FrozenRowCount = *rows
+		grid.ForceSendFields = append(grid.ForceSendFields, "FrozenRowCount")
+		fields = append(fields, "gridProperties.frozenRowCount")
+	}
+	if columns != nil {
+		if *columns < 0 {
+			return nil, fmt.Errorf("columns must not be negative")
+		}
+		grid.FrozenColumnCount = *columns
+		grid.ForceSendFields = append(grid.ForceSendFields, "FrozenColumnCount")
+		fields = append(fields, "gridProperties.frozenColumnCount")
+	}
+
+	props := &sheets.SheetProperties{GridProperties: grid}
+	return c.updateSheetProperties(ctx, spreadsheetID, sheet, props, strings.Join(fields, ","), "Frozen panes updated successfully")
+}
+
+// GridOptions describes changes to a sheet's grid. Nil fields are left unchanged.
+type GridOptions struct {
+	RowCount      *int64
+	ColumnCount   *int64
+	HideGridlines *bool
+}
+
+// UpdateGridProperties resizes a sheet's grid and toggles gridline visibility
+func (c *Client) UpdateGridProperties(ctx context.Context, spreadsheetID, sheet string, opts GridOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	grid := &sheets.GridProperties{}
+	var fields []string
+	if opts.RowCount != nil {
+		if *opts.RowCount < 1 {
+			return nil, fmt.Errorf("row_count must be at least 1")
+		}
+		grid.RowCount = *opts.RowCount
+		fields = append(fields, "gridProperties.rowCount")
+	}
+	if opts.ColumnCount != nil {
+		if *opts.ColumnCount < 1 {
+			return nil, fmt.Errorf("column_count must be at least 1")
+		}
+		grid.ColumnCount = *opts.ColumnCount
+		fields = append(fields, "gridProperties.columnCount")
+	}
+	if opts.HideGridlines != nil {
+		grid.HideGridlines = *opts.HideGridlines
+		grid.ForceSendFields = append(grid.ForceSendFields, "HideGridlines")
+		fields = append(fields, "gridProperties.hideGridlines")
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("at least one of row_count, column_count or hide_gridlines is required")
+	}
+
+	props := &sheets.SheetProperties{GridProperties: grid}
+	return c.updateSheetProperties(ctx, spreadsheetID, sheet, props, strings.Join(fields, ","), "Grid properties updated successfully")
+}
+
+// DeleteSheet removes a sheet and all of its data
+func (c *Client) DeleteSheet(ctx context.Context, spreadsheetID, sheet string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if sheet == "" {
+		return nil, fmt.Errorf("sheet is required")
+	}
+
+	existing, err := c.findSheet(ctx, spreadsheetID, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				DeleteSheet: &sheets.DeleteSheetRequest{
+					SheetId:         existing.SheetId,
+					ForceSendFields: []string{"SheetId"},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to delete sheet: %v", err)
+	}
+	c.invalidateMetadata(spreadsheetID)
+
+	result := sheetPropertiesInfo(existing)
+	result["message"] = "Sheet deleted successfully"
+	return result, nil
+}
+
+// DuplicateSheet copies a sheet within the same spreadsheet. newTitle and
+// index are optional; a negative index places the copy after the original.
+func (c *Client) DuplicateSheet(ctx context.Context, spreadsheetID, sheet, newTitle string, index int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findSheet(ctx, spreadsheetID, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate := &sheets.DuplicateSheetRequest{
+		SourceSheetId:    existing.SheetId,
+		NewSheetName:     newTitle,
+		InsertSheetIndex: existing.Index + 1,
+		ForceSendFields:  []string{"SourceSheetId", "InsertSheetIndex"},
+	}
+	if index >= 0 {
+		duplicate.InsertSheetIndex = index
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{DuplicateSheet: duplicate},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to duplicate sheet: %v", err)
+	}
+	c.invalidateMetadata(spreadsheetID)
+
+	if len(resp.Replies) > 0 && resp.Replies[0].DuplicateSheet != nil && resp.Replies[0].DuplicateSheet.Properties != nil {
+		result := sheetPropertiesInfo(resp.Replies[0].DuplicateSheet.Properties)
+		result["message"] = "Sheet duplicated successfully"
+		return result, nil
+	}
+
+	return map[string]interface{}{
+		"message": "Sheet duplicated successfully",
+	}, nil
+}
+
+// CopySheetTo copies a sheet into another spreadsheet, where it is added as
+// the last tab. The copy is titled "Copy of <title>" unless newTitle is given.
+func (c *Client) CopySheetTo(ctx context.Context, spreadsheetID, sheet, destinationSpreadsheetID, newTitle string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if destinationSpreadsheetID == "" {
+		return nil, fmt.Errorf("destination_spreadsheet_id is required")
+	}
+
+	existing, err := c.findSheet(ctx, spreadsheetID, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	copyRequest := &sheets.CopySheetToAnotherSpreadsheetRequest{
+		DestinationSpreadsheetId: destinationSpreadsheetID,
+	}
+
+	if dryrun.Enabled(ctx) {
+		// Reading the destination checks that it exists and is accessible
+		if _, err := c.metadata(ctx, destinationSpreadsheetID); err != nil {
+			return nil, err
+		}
+		title := newTitle
+		if title == "" {
+			title = "Copy of " + existing.Title
+		}
+		return dryRunResult(spreadsheetID, map[string]interface{}{
+			"sheet_id":                   existing.SheetId,
+			"sheet":                      existing.Title,
+			"destination_spreadsheet_id": destinationSpreadsheetID,
+			"title":                      title,
+		}), nil
+	}
+
+	copied, err := c.service.Spreadsheets.Sheets.CopyTo(spreadsheetID, existing.SheetId, copyRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy sheet: %v", err)
+	}
+	c.invalidateMetadata(destinationSpreadsheetID)
+
+	if newTitle != "" && newTitle != copied.Title {
+		props := &sheets.SheetProperties{Title: newTitle}
+		result, err := c.updateSheetProperties(ctx, destinationSpreadsheetID, fmt.Sprintf("%d", copied.SheetId), props, "title", "Sheet copied successfully")
+		if err != nil {
+			return nil, fmt.Errorf("sheet copied as %q but could not be renamed: %v", copied.Title, err)
+		}
+		result.(map[string]interface{})["destination_spreadsheet_id"] = destinationSpreadsheetID
+		return result, nil
+	}
+
+	result := sheetPropertiesInfo(copied)
+	result["destination_spreadsheet_id"] = destinationSpreadsheetID
+	result["message"] = "Sheet copied successfully"
+	return result, nil
+}
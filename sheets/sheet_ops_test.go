@@ -0,0 +1,255 @@
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestParseHexColor(t *testing.T) {
+	color, err := parseHexColor("#FF8000")
+	if err != nil {
+		t.Fatalf("parseHexColor failed: %v", err)
+	}
+	if color.Red != 1 || color.Blue != 0 || color.Green < 0.5 || color.Green > 0.51 {
+		t.Errorf("Unexpected color: %+v", color)
+	}
+	if got := hexColor(color); got != "#FF8000" {
+		t.Errorf("hexColor = %q, want '#FF8000'", got)
+	}
+
+	if short, err := parseHexColor("0f0"); err != nil || hexColor(short) != "#00FF00" {
+		t.Errorf("Expected short hex form to expand to #00FF00, got %v (%v)", hexColor(short), err)
+	}
+
+	if _, err := parseHexColor("red"); err == nil {
+		t.Error("Expected error for invalid hex color")
+	}
+}
+
+func TestRenameSheet_ByTitle(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Data"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.RenameSheet(context.Background(), "test-spreadsheet-id", "Data", "Archive")
+	if err != nil {
+		t.Fatalf("RenameSheet failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateSheetProperties
+	if update.Properties.SheetId != 1 || update.Properties.Title != "Archive" || update.Fields != "title" {
+		t.Errorf("Unexpected update request: %+v fields=%q", update.Properties, update.Fields)
+	}
+
+	if result.(map[string]interface{})["sheet_id"] != int64(1) {
+		t.Errorf("Expected resulting properties for sheet 1, got %v", result)
+	}
+}
+
+func TestSetTabColor_BySheetID(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Data"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.SetTabColor(context.Background(), "test-spreadsheet-id", "1", "#00FF00"); err != nil {
+		t.Fatalf("SetTabColor failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateSheetProperties
+	if update.Properties.SheetId != 1 || hexColorStyle(update.Properties.TabColorStyle) != "#00FF00" {
+		t.Errorf("Unexpected tab color update: %+v", update.Properties)
+	}
+}
+
+func TestDeleteSheet_NotFound(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.DeleteSheet(context.Background(), "test-spreadsheet-id", "Missing"); err == nil {
+		t.Error("Expected error for unknown sheet")
+	}
+}
+
+func TestSheetOps_MissingSheet(t *testing.T) {
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Sheet2"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		t.Errorf("Expected no batch update without a sheet, got %+v", req.Requests)
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := context.Background()
+
+	calls := map[string]func() (interface{}, error){
+		"delete_sheet":     func() (interface{}, error) { return client.DeleteSheet(ctx, "test-spreadsheet-id", "") },
+		"rename_sheet":     func() (interface{}, error) { return client.RenameSheet(ctx, "test-spreadsheet-id", "", "New") },
+		"move_sheet":       func() (interface{}, error) { return client.MoveSheet(ctx, "test-spreadsheet-id", "", 1) },
+		"set_sheet_hidden": func() (interface{}, error) { return client.SetSheetHidden(ctx, "test-spreadsheet-id", "", true) },
+		"set_tab_color":    func() (interface{}, error) { return client.SetTabColor(ctx, "test-spreadsheet-id", "", "#ff0000") },
+	}
+	for name, call := range calls {
+		if _, err := call(); err == nil || err.Error() != "sheet is required" {
+			t.Errorf("%s: expected sheet is required error, got %v", name, err)
+		}
+	}
+}
+
+func TestDuplicateSheet_DefaultIndex(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Template"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{DuplicateSheet: &sheets.DuplicateSheetResponse{Properties: &sheets.SheetProperties{SheetId: 77, Title: "March", Index: 2}}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.DuplicateSheet(context.Background(), "test-spreadsheet-id", "Template", "March", -1)
+	if err != nil {
+		t.Fatalf("DuplicateSheet failed: %v", err)
+	}
+
+	duplicate := received.Requests[0].DuplicateSheet
+	if duplicate.SourceSheetId != 1 || duplicate.InsertSheetIndex != 2 {
+		t.Errorf("Unexpected duplicate request: %+v", duplicate)
+	}
+
+	if result.(map[string]interface{})["sheet_id"] != int64(77) {
+		t.Errorf("Expected sheet_id 77, got %v", result.(map[string]interface{})["sheet_id"])
+	}
+}
+
+func TestFreezePanes_Unfreeze(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Data"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	rows := int64(0)
+
+	if _, err := client.FreezePanes(context.Background(), "test-spreadsheet-id", "Data", &rows, nil); err != nil {
+		t.Fatalf("FreezePanes failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateSheetProperties
+	if update.Fields != "gridProperties.frozenRowCount" || update.Properties.SheetId != 1 {
+		t.Errorf("Unexpected freeze request: fields=%q props=%+v", update.Fields, update.Properties)
+	}
+
+	if _, err := client.FreezePanes(context.Background(), "test-spreadsheet-id", "Data", nil, nil); err == nil {
+		t.Error("Expected error when neither rows nor columns is given")
+	}
+}
+
+func TestUpdateGridProperties_Fields(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	rows := int64(200)
+	hide := true
+
+	if _, err := client.UpdateGridProperties(context.Background(), "test-spreadsheet-id", "Sheet1", GridOptions{RowCount: &rows, HideGridlines: &hide}); err != nil {
+		t.Fatalf("UpdateGridProperties failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateSheetProperties
+	if update.Fields != "gridProperties.rowCount,gridProperties.hideGridlines" {
+		t.Errorf("Unexpected fields: %q", update.Fields)
+	}
+	if grid := update.Properties.GridProperties; grid.RowCount != 200 || !grid.HideGridlines {
+		t.Errorf("Unexpected grid properties: %+v", grid)
+	}
+
+	if _, err := client.UpdateGridProperties(context.Background(), "test-spreadsheet-id", "Sheet1", GridOptions{}); err == nil {
+		t.Error("Expected error when no grid properties are given")
+	}
+}
+
+func TestCopySheetTo_Rename(t *testing.T) {
+	source := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Template"), nil)
+
+	destination := testSpreadsheet("Sheet1")
+	destination.Sheets = append(destination.Sheets, &sheets.Sheet{
+		Properties: &sheets.SheetProperties{SheetId: 99, Title: "Copy of Template", Index: 1},
+	})
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	dest := mockSpreadsheetHandler(t, destination, func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/sheets/1:copyTo"):
+			var req sheets.CopySheetToAnotherSpreadsheetRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.DestinationSpreadsheetId != "dest-spreadsheet-id" {
+				t.Errorf("Unexpected destination: %q", req.DestinationSpreadsheetId)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&sheets.SheetProperties{SheetId: 99, Title: "Copy of Template", Index: 1})
+		case strings.Contains(r.URL.Path, "dest-spreadsheet-id"):
+			dest(w, r)
+		default:
+			source(w, r)
+		}
+	}
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.CopySheetTo(context.Background(), "test-spreadsheet-id", "Template", "dest-spreadsheet-id", "March")
+	if err != nil {
+		t.Fatalf("CopySheetTo failed: %v", err)
+	}
+
+	update := received.Requests[0].UpdateSheetProperties
+	if update.Properties.SheetId != 99 || update.Properties.Title != "March" {
+		t.Errorf("Unexpected rename request: %+v", update.Properties)
+	}
+	if result.(map[string]interface{})["destination_spreadsheet_id"] != "dest-spreadsheet-id" {
+		t.Errorf("Unexpected result: %v", result)
+	}
+}
@@ -0,0 +1,317 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// SlicerOptions describes a slicer. Column is a header name or column letter
+// within DataRange. On update, empty fields and nil slices are left unchanged.
+type SlicerOptions struct {
+	DataRange          string
+	Column             string
+	AnchorCell         string
+	Title              string
+	HiddenValues       []string
+	Condition          string
+	ConditionValues    []string
+	ApplyToPivotTables *bool
+}
+
+// slicerInfo summarises a slicer for tool output
+func slicerInfo(slicer *sheets.Slicer, titles map[int64]string) map[string]interface{} {
+	info := map[string]interface{}{
+		"slicer_id": slicer.SlicerId,
+	}
+	if spec := slicer.Spec; spec != nil {
+		info["title"] = spec.Title
+		if spec.DataRange != nil {
+			info["data_range"] = formatGridRange(titles[spec.DataRange.SheetId], spec.DataRange)
+			info["column"] = indexToColumn(spec.DataRange.StartColumnIndex + spec.ColumnIndex)
+		}
+		info["apply_to_pivot_tables"] = spec.ApplyToPivotTables
+		filterCriteriaInfo(info, spec.FilterCriteria)
+	}
+	if slicer.Position != nil && slicer.Position.OverlayPosition != nil && slicer.Position.OverlayPosition.AnchorCell != nil {
+		anchor := slicer.Position.OverlayPosition.AnchorCell
+		prefix := ""
+		if title := titles[anchor.SheetId]; title != "" {
+			prefix = quoteSheetName(title) + "!"
+		}
+		info["anchor_cell"] = prefix + cellAddress(anchor.RowIndex, anchor.ColumnIndex)
+	}
+	return info
+}
+
+// findSlicer returns an existing slicer by ID
+func (c *Client) findSlicer(ctx context.Context, spreadsheetID string, slicerID int64) (*sheets.Slicer, error) {
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.slicers").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve slicers: %v", err)
+	}
+
+	for _, sheet := range resp.Sheets {
+		for _, slicer := range sheet.Slicers {
+			if slicer.SlicerId == slicerID {
+				return slicer, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("slicer %d not found", slicerID)
+}
+
+// buildSlicerSpec converts options into a sheets.SlicerSpec and the list of
+// fields that were set. existing supplies the data range when opts.DataRange is empty.
+func (c *Client) buildSlicerSpec(ctx context.Context, spreadsheetID string, opts SlicerOptions, existing *sheets.GridRange) (*sheets.SlicerSpec, []string, error) {
+	spec := &sheets.SlicerSpec{}
+	var fields []string
+
+	if opts.Title != "" {
+		spec.Title = opts.Title
+		fields = append(fields, "title")
+	}
+
+	gr := existing
+	sheetTitle := ""
+	if opts.DataRange != "" {
+		resolved, sheet, err := c.resolveRange(ctx, spreadsheetID, opts.DataRange)
+		if err != nil {
+			return nil, nil, err
+		}
+		gr, sheetTitle = resolved, sheet.Title
+		spec.DataRange = gr
+		fields = append(fields, "dataRange")
+	} else if gr != nil {
+		meta, err := c.metadata(ctx, spreadsheetID)
+		if err != nil {
+			return nil, nil, err
+		}
+		sheetTitle = meta.sheetTitle(gr.SheetId)
+	}
+
+	if opts.Column != "" {
+		if gr == nil {
+			return nil, nil, fmt.Errorf("data_range is required to resolve the column")
+		}
+		headers, err := c.headerRow(ctx, spreadsheetID, gr, sheetTitle)
+		if err != nil {
+			return nil, nil, err
+		}
+		offset, err := columnOffset(headers, gr, opts.Column)
+		if err != nil {
+			return nil, nil, err
+		}
+		spec.ColumnIndex = offset
+		spec.ForceSendFields = append(spec.ForceSendFields, "ColumnIndex")
+		fields = append(fields, "columnIndex")
+	}
+
+	if opts.HiddenValues != nil || opts.Condition != "" {
+		spec.FilterCriteria = &sheets.FilterCriteria{HiddenValues: opts.HiddenValues}
+		if opts.Condition != "" {
+			spec.FilterCriteria.Condition = booleanCondition(opts.Condition, opts.ConditionValues)
+		}
+		fields = append(fields, "filterCriteria")
+	}
+
+	if opts.ApplyToPivotTables != nil {
+		spec.ApplyToPivotTables = *opts.ApplyToPivotTables
+		spec.ForceSendFields = append(spec.ForceSendFields, "ApplyToPivotTables")
+		fields = append(fields, "applyToPivotTables")
+	}
+
+	return spec, fields, nil
+}
+
+// CreateSlicer adds a slicer that filters a data range by one column and is
+// placed over the grid at the anchor cell
+func (c *Client) CreateSlicer(ctx context.Context, spreadsheetID string, opts SlicerOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if opts.DataRange == "" || opts.Column == "" || opts.AnchorCell == "" {
+		return nil, fmt.Errorf("data_range, column and anchor_cell are required")
+	}
+
+	spec, _, err := c.buildSlicerSpec(ctx, spreadsheetID, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	anchor, err := c.gridCoordinate(ctx, spreadsheetID, opts.AnchorCell)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor cell: %v", err)
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				AddSlicer: &sheets.AddSlicerRequest{
+					Slicer: &sheets.Slicer{
+						Spec: spec,
+						Position: &sheets.EmbeddedObjectPosition{
+							OverlayPosition: &sheets.OverlayPosition{AnchorCell: anchor},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create slicer: %v", err)
+	}
+
+	result := map[string]interface{}{
+		"data_range":  opts.DataRange,
+		"column":      opts.Column,
+		"anchor_cell": opts.AnchorCell,
+		"message":     "Slicer created successfully",
+	}
+	if len(resp.Replies) > 0 && resp.Replies[0].AddSlicer != nil && resp.Replies[0].AddSlicer.Slicer != nil {
+		result["slicer_id"] = resp.Replies[0].AddSlicer.Slicer.SlicerId
+	}
+
+	return result, nil
+}
+
+// ListSlicers lists slicers, optionally limited to a single sheet
+func (c *Client) ListSlicers(ctx context.Context, spreadsheetID, sheet string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties", "sheets.slicers").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve slicers: %v", err)
+	}
+
+	titles := sheetTitles(resp.Sheets)
+	slicers := []map[string]interface{}{}
+	for _, s := range resp.Sheets {
+		if sheet != "" && s.Properties != nil && s.Properties.Title != sheet && fmt.Sprintf("%d", s.Properties.SheetId) != sheet {
+			continue
+		}
+		for _, slicer := range s.Slicers {
+			slicers = append(slicers, slicerInfo(slicer, titles))
+		}
+	}
+
+	return map[string]interface{}{
+		"slicers": slicers,
+		"count":   len(slicers),
+	}, nil
+}
+
+// UpdateSlicer changes a slicer's spec and/or moves it to a new anchor cell
+func (c *Client) UpdateSlicer(ctx context.Context, spreadsheetID string, slicerID int64, opts SlicerOptions) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	var existing *sheets.GridRange
+	if opts.DataRange == "" && opts.Column != "" {
+		slicer, err := c.findSlicer(ctx, spreadsheetID, slicerID)
+		if err != nil {
+			return nil, err
+		}
+		if slicer.Spec != nil {
+			existing = slicer.Spec.DataRange
+		}
+	}
+
+	spec, fields, err := c.buildSlicerSpec(ctx, spreadsheetID, opts, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []*sheets.Request
+	if len(fields) > 0 {
+		requests = append(requests, &sheets.Request{
+			UpdateSlicerSpec: &sheets.UpdateSlicerSpecRequest{
+				SlicerId: slicerID,
+				Spec:     spec,
+				Fields:   strings.Join(fields, ","),
+			},
+		})
+	}
+
+	if opts.AnchorCell != "" {
+		anchor, err := c.gridCoordinate(ctx, spreadsheetID, opts.AnchorCell)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor cell: %v", err)
+		}
+		requests = append(requests, &sheets.Request{
+			UpdateEmbeddedObjectPosition: &sheets.UpdateEmbeddedObjectPositionRequest{
+				ObjectId: slicerID,
+				NewPosition: &sheets.EmbeddedObjectPosition{
+					OverlayPosition: &sheets.OverlayPosition{AnchorCell: anchor},
+				},
+				Fields: "overlayPosition",
+			},
+		})
+		fields = append(fields, "anchorCell")
+	}
+
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("no slicer changes specified")
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{Requests: requests}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to update slicer: %v", err)
+	}
+
+	return map[string]interface{}{
+		"slicer_id":      slicerID,
+		"updated_fields": fields,
+		"message":        "Slicer updated successfully",
+	}, nil
+}
+
+// DeleteSlicer removes a slicer
+func (c *Client) DeleteSlicer(ctx context.Context, spreadsheetID string, slicerID int64) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				DeleteEmbeddedObject: &sheets.DeleteEmbeddedObjectRequest{
+					ObjectId:        slicerID,
+					ForceSendFields: []string{"ObjectId"},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to delete slicer: %v", err)
+	}
+
+	return map[string]interface{}{
+		"slicer_id": slicerID,
+		"message":   "Slicer deleted successfully",
+	}, nil
+}
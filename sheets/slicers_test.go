@@ -0,0 +1,117 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestCreateSlicer_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sales", "Dashboard"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{
+			Replies: []*sheets.Response{
+				{AddSlicer: &sheets.AddSlicerResponse{Slicer: &sheets.Slicer{SlicerId: 9}}},
+			},
+		}
+	})
+
+	service, server := mockSheetsService(t, withHeaderRow(handler, "Region", "Product", "Revenue"))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.CreateSlicer(context.Background(), "test-spreadsheet-id", SlicerOptions{
+		DataRange:  "Sales!B1:D100",
+		Column:     "Product",
+		AnchorCell: "Dashboard!A1",
+		Title:      "Product",
+	})
+	if err != nil {
+		t.Fatalf("CreateSlicer failed: %v", err)
+	}
+
+	if result.(map[string]interface{})["slicer_id"] != int64(9) {
+		t.Errorf("Expected slicer_id 9, got %v", result.(map[string]interface{})["slicer_id"])
+	}
+
+	slicer := received.Requests[0].AddSlicer.Slicer
+	if slicer.Spec.ColumnIndex != 1 || slicer.Spec.DataRange.SheetId != 0 {
+		t.Errorf("Unexpected slicer spec: %+v", slicer.Spec)
+	}
+	if slicer.Position.OverlayPosition.AnchorCell.SheetId != 1 {
+		t.Errorf("Expected slicer on Dashboard, got %+v", slicer.Position.OverlayPosition.AnchorCell)
+	}
+
+	if _, err := client.CreateSlicer(context.Background(), "test-spreadsheet-id", SlicerOptions{DataRange: "Sales!A1:C10"}); err == nil {
+		t.Error("Expected error when column and anchor_cell are missing")
+	}
+}
+
+func TestUpdateSlicer_CriteriaAndPosition(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sales", "Dashboard"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	_, err := client.UpdateSlicer(context.Background(), "test-spreadsheet-id", 9, SlicerOptions{
+		HiddenValues: []string{"Legacy"},
+		AnchorCell:   "Dashboard!E1",
+	})
+	if err != nil {
+		t.Fatalf("UpdateSlicer failed: %v", err)
+	}
+
+	if len(received.Requests) != 2 {
+		t.Fatalf("Expected spec and position requests, got %d", len(received.Requests))
+	}
+	if update := received.Requests[0].UpdateSlicerSpec; update.Fields != "filterCriteria" || update.Spec.FilterCriteria.HiddenValues[0] != "Legacy" {
+		t.Errorf("Unexpected spec update: %+v", update)
+	}
+	if move := received.Requests[1].UpdateEmbeddedObjectPosition; move.ObjectId != 9 || move.NewPosition.OverlayPosition.AnchorCell.ColumnIndex != 4 {
+		t.Errorf("Unexpected position update: %+v", move)
+	}
+}
+
+func TestListSlicers_Info(t *testing.T) {
+	spreadsheet := testSpreadsheet("Sales", "Dashboard")
+	spreadsheet.Sheets[1].Slicers = []*sheets.Slicer{
+		{
+			SlicerId: 9,
+			Spec: &sheets.SlicerSpec{
+				Title:       "Product",
+				DataRange:   &sheets.GridRange{SheetId: 0, StartRowIndex: 0, EndRowIndex: 100, StartColumnIndex: 1, EndColumnIndex: 4},
+				ColumnIndex: 1,
+			},
+			Position: &sheets.EmbeddedObjectPosition{
+				OverlayPosition: &sheets.OverlayPosition{AnchorCell: &sheets.GridCoordinate{SheetId: 1}},
+			},
+		},
+	}
+
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, spreadsheet, nil))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.ListSlicers(context.Background(), "test-spreadsheet-id", "")
+	if err != nil {
+		t.Fatalf("ListSlicers failed: %v", err)
+	}
+
+	slicers := result.(map[string]interface{})["slicers"].([]map[string]interface{})
+	if len(slicers) != 1 {
+		t.Fatalf("Expected 1 slicer, got %d", len(slicers))
+	}
+	if slicers[0]["data_range"] != "Sales!B1:D100" || slicers[0]["column"] != "C" || slicers[0]["anchor_cell"] != "Dashboard!A1" {
+		t.Errorf("Unexpected slicer info: %v", slicers[0])
+	}
+}
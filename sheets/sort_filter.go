@@ -0,0 +1,275 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// SortSpec orders rows by a column, given by header name or column letter.
+// Order is ASCENDING (default) or DESCENDING.
+type SortSpec struct {
+	Column string `json:"column"`
+	Order  string `json:"order,omitempty"`
+}
+
+// FilterCriterion hides rows based on one column. Rows are hidden if the
+// column's value is one of HiddenValues, or if it fails Condition (a
+// BooleanCondition type such as TEXT_CONTAINS) with ConditionValues.
+type FilterCriterion struct {
+	Column          string   `json:"column"`
+	HiddenValues    []string `json:"hidden_values,omitempty"`
+	Condition       string   `json:"condition,omitempty"`
+	ConditionValues []string `json:"condition_values,omitempty"`
+}
+
+// sortOrder normalises a user supplied sort order to the API enum
+func sortOrder(order string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(order)) {
+	case "", "ASC", "ASCENDING":
+		return "ASCENDING", nil
+	case "DESC", "DESCENDING":
+		return "DESCENDING", nil
+	default:
+		return "", fmt.Errorf("invalid sort order %q (expected ASCENDING or DESCENDING)", order)
+	}
+}
+
+// buildSortSpecs resolves sort columns against the header row of gr
+func buildSortSpecs(headers []string, gr *sheets.GridRange, specs []SortSpec) ([]*sheets.SortSpec, error) {
+	var result []*sheets.SortSpec
+	for _, s := range specs {
+		offset, err := columnOffset(headers, gr, s.Column)
+		if err != nil {
+			return nil, err
+		}
+
+		order, err := sortOrder(s.Order)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &sheets.SortSpec{
+			DimensionIndex:  gr.StartColumnIndex + offset,
+			SortOrder:       order,
+			ForceSendFields: []string{"DimensionIndex"},
+		})
+	}
+	return result, nil
+}
+
+// buildFilterSpecs resolves filter columns against the header row of gr
+func buildFilterSpecs(headers []string, gr *sheets.GridRange, criteria []FilterCriterion) ([]*sheets.FilterSpec, error) {
+	var result []*sheets.FilterSpec
+	for _, f := range criteria {
+		offset, err := columnOffset(headers, gr, f.Column)
+		if err != nil {
+			return nil, err
+		}
+
+		filter := &sheets.FilterCriteria{HiddenValues: f.HiddenValues}
+		if f.Condition != "" {
+			filter.Condition = booleanCondition(f.Condition, f.ConditionValues)
+		}
+
+		result = append(result, &sheets.FilterSpec{
+			ColumnIndex:     gr.StartColumnIndex + offset,
+			FilterCriteria:  filter,
+			ForceSendFields: []string{"ColumnIndex"},
+		})
+	}
+	return result, nil
+}
+
+// SortRange sorts the rows of a range in place. When hasHeader is set the
+// first row is left where it is and its values can be used as column names.
+func (c *Client) SortRange(ctx context.Context, spreadsheetID, rangeA1 string, specs []SortSpec, hasHeader bool) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("at least one sort spec is required")
+	}
+
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	var headers []string
+	sortRange := *gr
+	if hasHeader {
+		if headers, err = c.headerRow(ctx, spreadsheetID, gr, sheet.Title); err != nil {
+			return nil, err
+		}
+		sortRange.StartRowIndex++
+		sortRange.ForceSendFields = append(sortRange.ForceSendFields, "StartRowIndex")
+	}
+
+	sortSpecs, err := buildSortSpecs(headers, gr, specs)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				SortRange: &sheets.SortRangeRequest{
+					Range:     &sortRange,
+					SortSpecs: sortSpecs,
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to sort range: %v", err)
+	}
+
+	// A bare sheet title cannot show the skipped header row, so a whole sheet
+	// is reported up to its last column
+	sorted := sortRange
+	if sorted.EndRowIndex == 0 && sorted.EndColumnIndex == 0 && sorted.StartRowIndex > 0 && sheet.GridProperties != nil {
+		sorted.EndColumnIndex = sheet.GridProperties.ColumnCount
+	}
+
+	return map[string]interface{}{
+		"range":      formatGridRange(sheet.Title, &sorted),
+		"header_row": hasHeader,
+		"sort_specs": len(sortSpecs),
+		"message":    "Range sorted successfully",
+	}, nil
+}
+
+// SetBasicFilter sets the basic filter on a range, replacing any existing
+// basic filter on that sheet. The first row of the range holds the headers.
+func (c *Client) SetBasicFilter(ctx context.Context, spreadsheetID, rangeA1 string, specs []SortSpec, criteria []FilterCriterion) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	gr, sheet, err := c.resolveRange(ctx, spreadsheetID, rangeA1)
+	if err != nil {
+		return nil, err
+	}
+
+	var headers []string
+	if len(specs) > 0 || len(criteria) > 0 {
+		if headers, err = c.headerRow(ctx, spreadsheetID, gr, sheet.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := &sheets.BasicFilter{Range: gr}
+	if filter.SortSpecs, err = buildSortSpecs(headers, gr, specs); err != nil {
+		return nil, err
+	}
+	if filter.FilterSpecs, err = buildFilterSpecs(headers, gr, criteria); err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				SetBasicFilter: &sheets.SetBasicFilterRequest{Filter: filter},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to set basic filter: %v", err)
+	}
+
+	return map[string]interface{}{
+		"range":        formatGridRange(sheet.Title, gr),
+		"sort_specs":   len(filter.SortSpecs),
+		"filter_specs": len(filter.FilterSpecs),
+		"message":      "Basic filter set successfully",
+	}, nil
+}
+
+// ClearBasicFilter removes the basic filter from a sheet, if there is one
+func (c *Client) ClearBasicFilter(ctx context.Context, spreadsheetID, sheet string) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findSheet(ctx, spreadsheetID, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{
+				ClearBasicFilter: &sheets.ClearBasicFilterRequest{
+					SheetId:         existing.SheetId,
+					ForceSendFields: []string{"SheetId"},
+				},
+			},
+		},
+	}
+
+	if dryrun.Enabled(ctx) {
+		return c.previewRequests(ctx, spreadsheetID, batchUpdateRequest.Requests)
+	}
+
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do(); err != nil {
+		return nil, fmt.Errorf("unable to clear basic filter: %v", err)
+	}
+
+	return map[string]interface{}{
+		"sheet":   existing.Title,
+		"message": "Basic filter cleared successfully",
+	}, nil
+}
+
+// sortSpecsInfo summarises sort specs for tool output, naming columns by letter
+func sortSpecsInfo(specs []*sheets.SortSpec) []map[string]interface{} {
+	info := make([]map[string]interface{}, len(specs))
+	for i, s := range specs {
+		info[i] = map[string]interface{}{
+			"column": indexToColumn(s.DimensionIndex),
+			"order":  s.SortOrder,
+		}
+	}
+	return info
+}
+
+// filterCriteriaInfo adds the hidden values and condition of a filter to info
+func filterCriteriaInfo(info map[string]interface{}, criteria *sheets.FilterCriteria) {
+	if criteria == nil {
+		return
+	}
+	if len(criteria.HiddenValues) > 0 {
+		info["hidden_values"] = criteria.HiddenValues
+	}
+	if cond := criteria.Condition; cond != nil {
+		info["condition"] = cond.Type
+		if values := conditionValues(cond); len(values) > 0 {
+			info["condition_values"] = values
+		}
+	}
+}
+
+// filterSpecsInfo summarises filter specs for tool output, naming columns by letter
+func filterSpecsInfo(specs []*sheets.FilterSpec) []map[string]interface{} {
+	info := make([]map[string]interface{}, len(specs))
+	for i, f := range specs {
+		info[i] = map[string]interface{}{"column": indexToColumn(f.ColumnIndex)}
+		filterCriteriaInfo(info[i], f.FilterCriteria)
+	}
+	return info
+}
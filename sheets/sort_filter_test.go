@@ -0,0 +1,150 @@
+package sheets
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestSortRange_ByHeaderAndLetter(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Orders"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, withHeaderRow(handler, "Date", "Customer", "Total"))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	result, err := client.SortRange(context.Background(), "test-spreadsheet-id", "Orders!B1:D50", []SortSpec{
+		{Column: "total", Order: "desc"},
+		{Column: "B"},
+	}, true)
+	if err != nil {
+		t.Fatalf("SortRange failed: %v", err)
+	}
+
+	sort := received.Requests[0].SortRange
+	if sort.Range.StartRowIndex != 1 || sort.Range.EndRowIndex != 50 {
+		t.Errorf("Expected header row to be excluded from sort, got %+v", sort.Range)
+	}
+	if got := result.(map[string]interface{})["range"]; got != "Orders!B2:D50" {
+		t.Errorf("Expected sorted range Orders!B2:D50, got %v", got)
+	}
+	if len(sort.SortSpecs) != 2 {
+		t.Fatalf("Expected 2 sort specs, got %d", len(sort.SortSpecs))
+	}
+	if sort.SortSpecs[0].DimensionIndex != 3 || sort.SortSpecs[0].SortOrder != "DESCENDING" {
+		t.Errorf("Unexpected first sort spec: %+v", sort.SortSpecs[0])
+	}
+	if sort.SortSpecs[1].DimensionIndex != 1 || sort.SortSpecs[1].SortOrder != "ASCENDING" {
+		t.Errorf("Unexpected second sort spec: %+v", sort.SortSpecs[1])
+	}
+}
+
+func TestSortRange_UnboundedWithHeader(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Orders"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, withHeaderRow(handler, "Date", "Customer", "Total"))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Orders!A:D", "Orders!A2:D"},
+		{"Orders", "Orders!A2:Z"},
+	}
+	for _, tt := range tests {
+		result, err := client.SortRange(context.Background(), "test-spreadsheet-id", tt.input, []SortSpec{{Column: "Total"}}, true)
+		if err != nil {
+			t.Fatalf("SortRange(%q) failed: %v", tt.input, err)
+		}
+		if sort := received.Requests[0].SortRange; sort.Range.StartRowIndex != 1 || sort.Range.EndRowIndex != 0 {
+			t.Errorf("SortRange(%q): expected rows from 2 to the end, got %+v", tt.input, sort.Range)
+		}
+		if got := result.(map[string]interface{})["range"]; got != tt.want {
+			t.Errorf("SortRange(%q) reported range %v, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSortRange_Validation(t *testing.T) {
+	service, server := mockSheetsService(t, withHeaderRow(mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), nil), "Name"))
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := context.Background()
+
+	if _, err := client.SortRange(ctx, "test-spreadsheet-id", "Sheet1!A1:A10", nil, true); err == nil {
+		t.Error("Expected error when no sort specs are given")
+	}
+	if _, err := client.SortRange(ctx, "test-spreadsheet-id", "Sheet1!A1:A10", []SortSpec{{Column: "Missing"}}, true); err == nil {
+		t.Error("Expected error for unknown column")
+	}
+	if _, err := client.SortRange(ctx, "test-spreadsheet-id", "Sheet1!A1:A10", []SortSpec{{Column: "A", Order: "sideways"}}, false); err == nil {
+		t.Error("Expected error for invalid sort order")
+	}
+}
+
+func TestSetBasicFilter_Criteria(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, withHeaderRow(handler, "Status", "Owner"))
+	defer server.Close()
+
+	client := NewClient(service)
+
+	_, err := client.SetBasicFilter(context.Background(), "test-spreadsheet-id", "Sheet1!A1:B100",
+		[]SortSpec{{Column: "Owner"}},
+		[]FilterCriterion{{Column: "Status", HiddenValues: []string{"Closed"}}, {Column: "Owner", Condition: "text_contains", ConditionValues: []string{"@example.com"}}},
+	)
+	if err != nil {
+		t.Fatalf("SetBasicFilter failed: %v", err)
+	}
+
+	filter := received.Requests[0].SetBasicFilter.Filter
+	if len(filter.FilterSpecs) != 2 || filter.FilterSpecs[0].FilterCriteria.HiddenValues[0] != "Closed" {
+		t.Fatalf("Unexpected filter specs: %+v", filter.FilterSpecs)
+	}
+	if cond := filter.FilterSpecs[1].FilterCriteria.Condition; filter.FilterSpecs[1].ColumnIndex != 1 || cond.Type != "TEXT_CONTAINS" {
+		t.Errorf("Unexpected condition filter: %+v", cond)
+	}
+	if filter.SortSpecs[0].DimensionIndex != 1 {
+		t.Errorf("Unexpected sort spec: %+v", filter.SortSpecs[0])
+	}
+}
+
+func TestClearBasicFilter_Success(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Sheet1", "Data"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		return &sheets.BatchUpdateSpreadsheetResponse{}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	if _, err := client.ClearBasicFilter(context.Background(), "test-spreadsheet-id", "Data"); err != nil {
+		t.Fatalf("ClearBasicFilter failed: %v", err)
+	}
+
+	if received.Requests[0].ClearBasicFilter.SheetId != 1 {
+		t.Errorf("Expected sheet 1, got %d", received.Requests[0].ClearBasicFilter.SheetId)
+	}
+}
@@ -0,0 +1,288 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+// TemplateSection is a block of template rows repeated once per record, such
+// as an invoice line. {{field}} placeholders in each copy are replaced from
+// the matching record; no records removes the block.
+type TemplateSection struct {
+	Range   string              `json:"range"`
+	Records []map[string]string `json:"records"`
+}
+
+// templateSection is a TemplateSection resolved to grid coordinates
+type templateSection struct {
+	TemplateSection
+	sheet *sheets.SheetProperties
+	gr    *sheets.GridRange
+}
+
+// placeholder renders a template key as it appears in cells
+func placeholder(key string) string {
+	return "{{" + key + "}}"
+}
+
+// sortedKeys returns a map's keys in a stable order so requests are deterministic
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// templateReplace builds a case-sensitive find and replace for one placeholder
+func templateReplace(key, value string, gr *sheets.GridRange) *sheets.Request {
+	request := &sheets.FindReplaceRequest{
+		Find:            placeholder(key),
+		Replacement:     value,
+		MatchCase:       true,
+		IncludeFormulas: true,
+		ForceSendFields: []string{"Replacement"},
+	}
+	if gr != nil {
+		request.Range = gr
+	} else {
+		request.AllSheets = true
+	}
+	return &sheets.Request{FindReplace: request}
+}
+
+// resolveSections resolves section ranges and orders them bottom-up per
+// sheet, so rows inserted for one section never shift a section still to be
+// expanded
+func (c *Client) resolveSections(ctx context.Context, spreadsheetID string, sections []TemplateSection) ([]templateSection, error) {
+	resolved := make([]templateSection, len(sections))
+	for i, section := range sections {
+		gr, sheet, err := c.resolveRange(ctx, spreadsheetID, section.Range)
+		if err != nil {
+			return nil, err
+		}
+		if gr.EndRowIndex <= gr.StartRowIndex {
+			return nil, fmt.Errorf("section range %q must specify rows", section.Range)
+		}
+		resolved[i] = templateSection{TemplateSection: section, sheet: sheet, gr: gr}
+	}
+
+	sort.SliceStable(resolved, func(i, j int) bool {
+		if resolved[i].gr.SheetId != resolved[j].gr.SheetId {
+			return resolved[i].gr.SheetId < resolved[j].gr.SheetId
+		}
+		return resolved[i].gr.StartRowIndex > resolved[j].gr.StartRowIndex
+	})
+
+	for i := 1; i < len(resolved); i++ {
+		above, below := resolved[i], resolved[i-1]
+		if above.gr.SheetId == below.gr.SheetId && above.gr.EndRowIndex > below.gr.StartRowIndex {
+			return nil, fmt.Errorf("sections %q and %q share rows", above.Range, below.Range)
+		}
+	}
+	return resolved, nil
+}
+
+// sectionRequests expands a section into one block of rows per record and
+// fills each block's placeholders
+func sectionRequests(section templateSection) []*sheets.Request {
+	gr := section.gr
+	height := gr.EndRowIndex - gr.StartRowIndex
+	rows := func(start, end int64) *sheets.DimensionRange {
+		return &sheets.DimensionRange{
+			SheetId:         gr.SheetId,
+			Dimension:       "ROWS",
+			StartIndex:      start,
+			EndIndex:        end,
+			ForceSendFields: []string{"SheetId", "StartIndex"},
+		}
+	}
+
+	if len(section.Records) == 0 {
+		return []*sheets.Request{{DeleteDimension: &sheets.DeleteDimensionRequest{Range: rows(gr.StartRowIndex, gr.EndRowIndex)}}}
+	}
+
+	var requests []*sheets.Request
+	if len(section.Records) > 1 {
+		added := height * int64(len(section.Records)-1)
+		requests = append(requests, &sheets.Request{
+			InsertDimension: &sheets.InsertDimensionRequest{Range: rows(gr.EndRowIndex, gr.EndRowIndex+added), InheritFromBefore: true},
+		})
+	}
+
+	blocks := make([]*sheets.GridRange, len(section.Records))
+	for i := range section.Records {
+		block := *gr
+		block.StartRowIndex = gr.StartRowIndex + int64(i)*height
+		block.EndRowIndex = block.StartRowIndex + height
+		blocks[i] = &block
+		if i > 0 {
+			requests = append(requests, &sheets.Request{
+				CopyPaste: &sheets.CopyPasteRequest{
+					Source:      gr,
+					Destination: blocks[i],
+					PasteType:   "PASTE_NORMAL",
+				},
+			})
+		}
+	}
+
+	for i, record := range section.Records {
+		for _, key := range sortedKeys(record) {
+			requests = append(requests, templateReplace(key, record[key], blocks[i]))
+		}
+	}
+	return requests
+}
+
+// FillTemplate fills a spreadsheet created from a template. Repeated sections
+// are expanded first, then every {{key}} in values is replaced on all sheets,
+// in cell values and formulas. All changes are sent in one batch update.
+func (c *Client) FillTemplate(ctx context.Context, spreadsheetID string, values map[string]string, sections []TemplateSection) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	for key := range values {
+		if key == "" {
+			return nil, fmt.Errorf("placeholder names must not be empty")
+		}
+	}
+
+	resolved, err := c.resolveSections(ctx, spreadsheetID, sections)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []*sheets.Request
+	sectionInfo := make([]map[string]interface{}, len(resolved))
+	for i, section := range resolved {
+		requests = append(requests, sectionRequests(section)...)
+		height := section.gr.EndRowIndex - section.gr.StartRowIndex
+		sectionInfo[i] = map[string]interface{}{
+			"range":   formatGridRange(section.sheet.Title, section.gr),
+			"records": len(section.Records),
+		}
+		if len(section.Records) == 0 {
+			sectionInfo[i]["rows_removed"] = height
+		} else {
+			sectionInfo[i]["rows_added"] = height * int64(len(section.Records)-1)
+		}
+	}
+
+	// Global replacements run last so they also reach the copied section rows
+	keys := sortedKeys(values)
+	firstGlobal := len(requests)
+	for _, key := range keys {
+		requests = append(requests, templateReplace(key, values[key], nil))
+	}
+
+	if dryrun.Enabled(ctx) {
+		preview, err := c.previewRequests(ctx, spreadsheetID, requests)
+		if err != nil {
+			return nil, err
+		}
+		preview["sections"] = sectionInfo
+		preview["placeholders"] = keys
+		return preview, nil
+	}
+
+	result := map[string]interface{}{
+		"spreadsheet_id": spreadsheetID,
+		"sections":       sectionInfo,
+		"message":        "Template filled successfully",
+	}
+	if len(requests) == 0 {
+		result["replacements"] = int64(0)
+		return result, nil
+	}
+
+	batchUpdateRequest := &sheets.BatchUpdateSpreadsheetRequest{Requests: requests}
+	resp, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fill template: %v", err)
+	}
+	c.invalidateMetadata(spreadsheetID)
+
+	var total int64
+	placeholders := make(map[string]int64, len(keys))
+	for _, key := range keys {
+		placeholders[key] = 0
+	}
+	unused := []string{}
+	for i, reply := range resp.Replies {
+		if reply == nil || reply.FindReplace == nil {
+			continue
+		}
+		total += reply.FindReplace.OccurrencesChanged
+		if i >= firstGlobal && i-firstGlobal < len(keys) {
+			placeholders[keys[i-firstGlobal]] = reply.FindReplace.OccurrencesChanged
+		}
+	}
+	for _, key := range keys {
+		if placeholders[key] == 0 {
+			unused = append(unused, key)
+		}
+	}
+
+	result["replacements"] = total
+	result["placeholders"] = placeholders
+	result["unused_placeholders"] = unused
+	return result, nil
+}
+
+// SpreadsheetCopier copies a spreadsheet file. The Drive client implements it.
+type SpreadsheetCopier interface {
+	CopySpreadsheet(ctx context.Context, spreadsheetID, name, folderID string) (interface{}, error)
+}
+
+// CreateFromTemplate copies a template with copier and fills the copy. A dry
+// run only previews the copy, so the template itself is previewed instead:
+// the copy would have the same layout.
+func (c *Client) CreateFromTemplate(ctx context.Context, copier SpreadsheetCopier, templateID, name, folderID string, values map[string]string, sections []TemplateSection) (interface{}, error) {
+	if err := c.checkService(); err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	copied, err := copier.CopySpreadsheet(ctx, templateID, name, folderID)
+	if err != nil {
+		return nil, err
+	}
+	result, ok := copied.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected copy result")
+	}
+	spreadsheetID, _ := result["spreadsheet_id"].(string)
+
+	filled, err := c.FillTemplate(ctx, spreadsheetID, values, sections)
+	if err != nil {
+		if dryrun.Enabled(ctx) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("spreadsheet %s was created but filling the template failed: %v", spreadsheetID, err)
+	}
+
+	fill, ok := filled.(map[string]interface{})
+	if !ok {
+		result["fill"] = filled
+		return result, nil
+	}
+	for key, value := range fill {
+		if key != "message" && key != "spreadsheet_id" {
+			result[key] = value
+		}
+	}
+	if !dryrun.Enabled(ctx) {
+		result["message"] = "Spreadsheet created from template successfully"
+	}
+	return result, nil
+}
@@ -0,0 +1,202 @@
+package sheets
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/conallob/mcp-google-sheets/dryrun"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestFillTemplate_SectionsAndValues(t *testing.T) {
+	var received *sheets.BatchUpdateSpreadsheetRequest
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Invoice"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		received = req
+		replies := make([]*sheets.Response, len(req.Requests))
+		for i, r := range req.Requests {
+			replies[i] = &sheets.Response{}
+			if r.FindReplace != nil && r.FindReplace.Find != "{{unused}}" {
+				replies[i].FindReplace = &sheets.FindReplaceResponse{OccurrencesChanged: 1}
+			}
+		}
+		return &sheets.BatchUpdateSpreadsheetResponse{Replies: replies}
+	})
+
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+
+	sections := []TemplateSection{
+		{
+			Range: "Invoice!A5:D5",
+			Records: []map[string]string{
+				{"item": "Widget", "qty": "2"},
+				{"item": "Gadget", "qty": "1"},
+				{"item": "Gizmo", "qty": "5"},
+			},
+		},
+		{Range: "Invoice!A10:D11"},
+	}
+	values := map[string]string{"customer": "Acme", "unused": "x"}
+
+	result, err := client.FillTemplate(context.Background(), "test-spreadsheet-id", values, sections)
+	if err != nil {
+		t.Fatalf("FillTemplate failed: %v", err)
+	}
+
+	requests := received.Requests
+	// Lower section first: its block is removed because it has no records
+	if del := requests[0].DeleteDimension; del == nil || del.Range.StartIndex != 9 || del.Range.EndIndex != 11 {
+		t.Fatalf("Expected lower section to be deleted first, got %+v", requests[0])
+	}
+	if insert := requests[1].InsertDimension; insert == nil || insert.Range.StartIndex != 5 || insert.Range.EndIndex != 7 {
+		t.Fatalf("Expected two rows inserted after the template row, got %+v", requests[1])
+	}
+	if paste := requests[3].CopyPaste; paste == nil || paste.Destination.StartRowIndex != 6 || paste.Destination.EndColumnIndex != 4 {
+		t.Fatalf("Expected template row copied to row 7, got %+v", requests[3])
+	}
+	first := requests[4].FindReplace
+	if first.Find != "{{item}}" || first.Replacement != "Widget" || first.Range.StartRowIndex != 4 || first.AllSheets {
+		t.Errorf("Unexpected first section replacement: %+v", first)
+	}
+	last := requests[len(requests)-1].FindReplace
+	if last.Find != "{{unused}}" || !last.AllSheets || !last.MatchCase || !last.IncludeFormulas {
+		t.Errorf("Unexpected global replacement: %+v", last)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["replacements"] != int64(7) {
+		t.Errorf("Expected 7 replacements, got %v", resultMap["replacements"])
+	}
+	if unused := resultMap["unused_placeholders"].([]string); len(unused) != 1 || unused[0] != "unused" {
+		t.Errorf("Unexpected unused placeholders: %v", unused)
+	}
+	sectionInfo := resultMap["sections"].([]map[string]interface{})
+	if sectionInfo[0]["rows_removed"] != int64(2) || sectionInfo[1]["rows_added"] != int64(2) {
+		t.Errorf("Unexpected section info: %v", sectionInfo)
+	}
+}
+
+func TestFillTemplate_Validation(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Invoice"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := context.Background()
+
+	if _, err := client.FillTemplate(ctx, "test-spreadsheet-id", nil, []TemplateSection{{Range: "Invoice!A:D"}}); err == nil {
+		t.Error("Expected error for a section without rows")
+	}
+	overlapping := []TemplateSection{{Range: "Invoice!A5:D6"}, {Range: "Invoice!A6:D7"}}
+	if _, err := client.FillTemplate(ctx, "test-spreadsheet-id", nil, overlapping); err == nil {
+		t.Error("Expected error for overlapping sections")
+	}
+	if _, err := client.FillTemplate(ctx, "test-spreadsheet-id", map[string]string{"": "x"}, nil); err == nil {
+		t.Error("Expected error for an empty placeholder name")
+	}
+
+	result, err := client.FillTemplate(ctx, "test-spreadsheet-id", nil, nil)
+	if err != nil {
+		t.Fatalf("FillTemplate failed: %v", err)
+	}
+	if result.(map[string]interface{})["replacements"] != int64(0) {
+		t.Errorf("Expected no replacements, got %v", result)
+	}
+}
+
+// fakeCopier records copy requests and returns a fixed result
+type fakeCopier struct {
+	result interface{}
+	calls  []string
+}
+
+func (f *fakeCopier) CopySpreadsheet(ctx context.Context, spreadsheetID, name, folderID string) (interface{}, error) {
+	f.calls = append(f.calls, spreadsheetID+"|"+name+"|"+folderID)
+	return f.result, nil
+}
+
+func TestCreateFromTemplate_CopiesAndFills(t *testing.T) {
+	handler := mockSpreadsheetHandler(t, testSpreadsheet("Invoice"), func(req *sheets.BatchUpdateSpreadsheetRequest) *sheets.BatchUpdateSpreadsheetResponse {
+		return &sheets.BatchUpdateSpreadsheetResponse{Replies: []*sheets.Response{
+			{FindReplace: &sheets.FindReplaceResponse{OccurrencesChanged: 2}},
+		}}
+	})
+	service, server := mockSheetsService(t, handler)
+	defer server.Close()
+
+	client := NewClient(service)
+	copier := &fakeCopier{result: map[string]interface{}{
+		"spreadsheet_id": "test-spreadsheet-id",
+		"name":           "Invoice 42",
+		"message":        "Spreadsheet copied successfully",
+	}}
+
+	result, err := client.CreateFromTemplate(context.Background(), copier, "template-id", "Invoice 42", "folder-1", map[string]string{"customer": "Acme"}, nil)
+	if err != nil {
+		t.Fatalf("CreateFromTemplate failed: %v", err)
+	}
+
+	if len(copier.calls) != 1 || copier.calls[0] != "template-id|Invoice 42|folder-1" {
+		t.Errorf("Unexpected copy requests: %v", copier.calls)
+	}
+	resultMap := result.(map[string]interface{})
+	if resultMap["name"] != "Invoice 42" || resultMap["replacements"] != int64(2) {
+		t.Errorf("Expected copy and fill results to be merged, got %v", resultMap)
+	}
+	if resultMap["message"] != "Spreadsheet created from template successfully" {
+		t.Errorf("Unexpected message: %v", resultMap["message"])
+	}
+}
+
+func TestCreateFromTemplate_DryRun(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Invoice"), noBatchUpdate(t)))
+	defer server.Close()
+
+	client := NewClient(service)
+	// A copy preview reports the template it would copy
+	copier := &fakeCopier{result: map[string]interface{}{
+		"dry_run":        true,
+		"spreadsheet_id": "test-spreadsheet-id",
+		"message":        dryrun.Message,
+	}}
+
+	result, err := client.CreateFromTemplate(dryrun.With(context.Background()), copier, "test-spreadsheet-id", "Invoice 42", "", map[string]string{"customer": "Acme"}, nil)
+	if err != nil {
+		t.Fatalf("CreateFromTemplate failed: %v", err)
+	}
+
+	resultMap := result.(map[string]interface{})
+	if resultMap["dry_run"] != true || resultMap["message"] != dryrun.Message {
+		t.Errorf("Expected a dry-run result, got %v", resultMap)
+	}
+	if changes := resultMap["changes"].([]map[string]interface{}); len(changes) != 1 {
+		t.Errorf("Expected one previewed replacement, got %v", changes)
+	}
+}
+
+func TestCreateFromTemplate_Errors(t *testing.T) {
+	service, server := mockSheetsService(t, mockSpreadsheetHandler(t, testSpreadsheet("Invoice"), nil))
+	defer server.Close()
+
+	client := NewClient(service)
+	ctx := context.Background()
+
+	copier := &fakeCopier{result: map[string]interface{}{"spreadsheet_id": "test-spreadsheet-id"}}
+	if _, err := client.CreateFromTemplate(ctx, copier, "template-id", "", "", nil, nil); err == nil {
+		t.Error("Expected error for a missing name")
+	}
+	if len(copier.calls) != 0 {
+		t.Errorf("Expected no copy without a name, got %v", copier.calls)
+	}
+
+	_, err := client.CreateFromTemplate(ctx, copier, "template-id", "Invoice 42", "", nil, []TemplateSection{{Range: "Invoice!A:D"}})
+	if err == nil || !strings.Contains(err.Error(), "test-spreadsheet-id was created") {
+		t.Errorf("Expected error naming the created spreadsheet, got %v", err)
+	}
+
+	if _, err := client.CreateFromTemplate(ctx, &fakeCopier{result: "copied"}, "template-id", "Invoice 42", "", nil, nil); err == nil {
+		t.Error("Expected error for an unexpected copy result")
+	}
+}